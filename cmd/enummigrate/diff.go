@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// unifiedDiff returns the differences between oldSrc & newSrc in unified diff format ("" if
+// they're the same).
+func unifiedDiff(oldName string, newName string, oldSrc []byte, newSrc []byte) string {
+	lines := diffLines(splitLines(string(oldSrc)), splitLines(string(newSrc)))
+	sb := &strings.Builder{}
+	for start := 0; start < len(lines); {
+		if lines[start].op == ' ' {
+			start++
+			continue
+		}
+		// Extend the hunk until diffContext*2 unchanged lines separate it from the next change
+		end, unchanged := start, 0
+		for k := start; k < len(lines) && unchanged <= diffContext*2; k++ {
+			if lines[k].op == ' ' {
+				unchanged++
+			} else {
+				end, unchanged = k+1, 0
+			}
+		}
+		from, to := max(start-diffContext, 0), min(end+diffContext, len(lines))
+		if sb.Len() == 0 {
+			fmt.Fprintf(sb, "--- %s\n+++ %s\n", oldName, newName)
+		}
+		oldCount, newCount := 0, 0
+		for _, l := range lines[from:to] {
+			if l.op != '+' {
+				oldCount++
+			}
+			if l.op != '-' {
+				newCount++
+			}
+		}
+		fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", lines[from].i+1, oldCount, lines[from].j+1, newCount)
+		for _, l := range lines[from:to] {
+			fmt.Fprintf(sb, "%c%s\n", l.op, l.text)
+		}
+		start = to
+	}
+	return sb.String()
+}
+
+// diffLine is a line of a diff: ' ' (unchanged), '-' (removed from a) or '+' (added from b).
+type diffLine struct {
+	op   byte
+	text string
+	i, j int // The line's index in a & b (before the line)
+}
+
+// diffLines returns the lines of a shortest diff between a & b; within each change, the removed
+// lines precede the added lines.
+func diffLines(a []string, b []string) []diffLine {
+	d := &differ{a: a, b: b}
+	d.compare(0, len(a), 0, len(b))
+	for start := 0; start < len(d.lines); { // Move each change's removed lines before its added lines
+		end := start
+		for end < len(d.lines) && d.lines[end].op != ' ' {
+			end++
+		}
+		sort.SliceStable(d.lines[start:end], func(x, y int) bool { return d.lines[start+x].op == '-' && d.lines[start+y].op == '+' })
+		start = end + 1
+	}
+	i, j := 0, 0
+	for n := range d.lines {
+		d.lines[n].i, d.lines[n].j = i, j
+		if d.lines[n].op != '+' {
+			i++
+		}
+		if d.lines[n].op != '-' {
+			j++
+		}
+	}
+	return d.lines
+}
+
+// differ finds a shortest diff using Myers' linear space algorithm ("An O(ND) Difference
+// Algorithm and Its Variations"), so large files don't need an O(len(a)*len(b)) table.
+type differ struct {
+	a, b  []string
+	lines []diffLine
+}
+
+// compare appends the diff between a[aLo:aHi] & b[bLo:bHi] to d.lines.
+func (d *differ) compare(aLo int, aHi int, bLo int, bHi int) {
+	for aLo < aHi && bLo < bHi && d.a[aLo] == d.b[bLo] { // The common prefix
+		d.lines = append(d.lines, diffLine{op: ' ', text: d.a[aLo]})
+		aLo, bLo = aLo+1, bLo+1
+	}
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && d.a[aHi-suffix-1] == d.b[bHi-suffix-1] {
+		suffix++
+	}
+	aHi, bHi = aHi-suffix, bHi-suffix
+	switch {
+	case aLo == aHi:
+		for ; bLo < bHi; bLo++ {
+			d.lines = append(d.lines, diffLine{op: '+', text: d.b[bLo]})
+		}
+	case bLo == bHi:
+		for ; aLo < aHi; aLo++ {
+			d.lines = append(d.lines, diffLine{op: '-', text: d.a[aLo]})
+		}
+	default: // Both are non-empty & differ at both ends so the diff has at least 2 edits
+		x, y, u, v := d.middleSnake(aLo, aHi, bLo, bHi)
+		d.compare(aLo, x, bLo, y)
+		for ; x < u; x++ {
+			d.lines = append(d.lines, diffLine{op: ' ', text: d.a[x]})
+		}
+		d.compare(u, aHi, v, bHi)
+	}
+	for ; suffix > 0; suffix-- {
+		d.lines = append(d.lines, diffLine{op: ' ', text: d.a[aHi]})
+		aHi++
+	}
+}
+
+// middleSnake returns the start (x, y) & end (u, v) of the middle snake (a run of equal lines
+// in the middle of a shortest edit path) of a[aLo:aHi] & b[bLo:bHi]; it searches forward from the
+// start & backward from the end until the paths overlap.
+func (d *differ) middleSnake(aLo int, aHi int, bLo int, bHi int) (x, y, u, v int) {
+	n, m := aHi-aLo, bHi-bLo
+	delta, odd := n-m, (n-m)%2 != 0
+	limit := (n + m + 1) / 2
+	offset := limit + 1 // Diagonals k (x - y) range from -limit-1 to limit+1 (plus delta backward)
+	vf, vb := make([]int, 2*offset+1), make([]int, 2*offset+1+abs(delta)*2)
+	backOffset := offset + abs(delta)
+	vf[offset+1], vb[backOffset+delta+1] = 0, n+1
+	for dist := 0; dist <= limit; dist++ {
+		for k := -dist; k <= dist; k += 2 { // Extend the forward paths
+			if k == -dist || (k != dist && vf[offset+k-1] < vf[offset+k+1]) {
+				x = vf[offset+k+1] // Down (insert b's line)
+			} else {
+				x = vf[offset+k-1] + 1 // Right (remove a's line)
+			}
+			y = x - k
+			u, v = x, y
+			for u < n && v < m && d.a[aLo+u] == d.b[bLo+v] {
+				u, v = u+1, v+1
+			}
+			vf[offset+k] = u
+			if odd && k >= delta-(dist-1) && k <= delta+(dist-1) && u >= vb[backOffset+k] {
+				return aLo + x, bLo + y, aLo + u, bLo + v
+			}
+		}
+		for k := delta - dist; k <= delta+dist; k += 2 { // Extend the backward paths
+			if k == delta-dist || (k != delta+dist && vb[backOffset+k+1]-1 < vb[backOffset+k-1]) {
+				u = vb[backOffset+k+1] - 1 // Left (remove a's line)
+			} else {
+				u = vb[backOffset+k-1] // Up (insert b's line)
+			}
+			v = u - k
+			x, y = u, v
+			for x > 0 && y > 0 && d.a[aLo+x-1] == d.b[bLo+y-1] {
+				x, y = x-1, y-1
+			}
+			vb[backOffset+k] = x
+			if !odd && k >= -dist && k <= dist && x <= vf[offset+k] {
+				return aLo + x, bLo + y, aLo + u, bLo + v
+			}
+		}
+	}
+	panic("enummigrate: no middle snake") // Unreachable: the paths always overlap
+}
+
+// abs returns the absolute value of i.
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// splitLines returns s's lines (without their line endings).
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,136 @@
+// Command enummigrate rewrites const/iota enumerated types (like ColorIdiomaticEnum) into
+// symbol-method enumerated types that use the enum package:
+//
+//	type Color int16                      type Color int16
+//	const (                               var EColor = Color(0).None()
+//	   ColorNone Color = iota      =>     func (Color) None() Color { return Color(0) }
+//	   ColorRed                           func (Color) Red() Color  { return Color(1) }
+//	)
+//
+// Every reference to a constant (like ColorRed or colors.ColorRed) in the specified directories
+// (and their subdirectories) is replaced with its symbol (like EColor.Red() or
+// colors.EColor.Red()). A hand-written String or Parse method whose body is an exhaustive switch
+// over the symbols (returning or parsing exactly the symbol names) is changed to call enum.String
+// or enum.Parse instead; its default case is kept so it behaves as before. Other String & Parse
+// methods are kept (and reported). Types whose constants are used in constant expressions (like
+// array lengths & indexes) are reported and left unchanged.
+//
+// The migrated packages are type-checked before any file is changed; if the migration introduces
+// a type error, the errors are reported and nothing is changed. Only the declarations that are
+// changed are formatted (like gofmt does); the rest of each file is left as it was.
+//
+// Usage:
+//
+//	enummigrate [-d] [-enum importpath] [dir ...]
+//
+// The -d flag displays a diff of each change instead of rewriting files.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	diffOnly := flag.Bool("d", false, "display diffs instead of rewriting files")
+	enumPkg := flag.String("enum", "github.com/JeffreyRichter/enum/enum", "the enum package's import path")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: enummigrate [-d] [-enum importpath] [dir ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	dirs := flag.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	m := newMigration(*enumPkg)
+	for _, dir := range dirs {
+		if err := m.load(dir); err != nil {
+			fmt.Fprintf(os.Stderr, "enummigrate: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	changed, err := m.rewrite()
+	for _, w := range m.warnings {
+		fmt.Fprintf(os.Stderr, "enummigrate: %s\n", w)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "enummigrate: %v\n", err)
+		os.Exit(1)
+	}
+	paths := make([]string, 0, len(changed))
+	for path := range changed {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if *diffOnly {
+			fmt.Print(unifiedDiff(path+".orig", path, m.source(path), changed[path]))
+			continue
+		}
+		if err := os.WriteFile(path, changed[path], 0o666); err != nil {
+			fmt.Fprintf(os.Stderr, "enummigrate: %v\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// load parses the Go files in dir & its subdirectories (skipping vendor & testdata directories
+// and those starting with "." or "_").
+func (m *migration) load(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != dir && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return m.addFile(path, importPath(filepath.Dir(path)), src)
+	})
+}
+
+// importPath returns the import path of the package in dir using the go.mod file of the module
+// containing dir or else GOPATH; it returns "" if neither is found.
+func importPath(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for root := abs; ; root = filepath.Dir(root) {
+		if data, err := os.ReadFile(filepath.Join(root, "go.mod")); err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+					rel, _ := filepath.Rel(root, abs)
+					return strings.TrimSuffix(fields[1]+"/"+filepath.ToSlash(rel), "/.")
+				}
+			}
+			return ""
+		}
+		if filepath.Dir(root) == root {
+			break // No go.mod file, try GOPATH
+		}
+	}
+	for _, gopath := range filepath.SplitList(os.Getenv("GOPATH")) {
+		if rel, err := filepath.Rel(filepath.Join(gopath, "src"), abs); err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,490 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+)
+
+// migrate migrates files (pairs of path & source; a file's import path is example.com/ & its
+// directory) and prints each changed file followed by the warnings & error.
+func migrate(files ...string) {
+	m := newMigration("github.com/JeffreyRichter/enum/enum")
+	for i := 0; i < len(files); i += 2 {
+		if err := m.addFile(files[i], "example.com/"+path.Dir(files[i]), []byte(files[i+1])); err != nil {
+			fmt.Println(err)
+		}
+	}
+	changed, err := m.rewrite()
+	paths := []string{}
+	for path := range changed {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fmt.Printf("==> %s\n%s", path, changed[path])
+	}
+	for _, w := range m.warnings {
+		fmt.Println(w)
+	}
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func Example() {
+	migrate("colors/colors.go", `package colors
+
+import "fmt"
+
+type Color int16
+
+const (
+	ColorNone Color = iota
+	ColorRed
+	ColorGreen // The default
+)
+
+// String converts c to its symbol.
+func (c Color) String() string {
+	switch c {
+	case ColorNone:
+		return "None"
+	case ColorRed:
+		return "Red"
+	case ColorGreen:
+		return "Green"
+	}
+	return fmt.Sprintf("Color(%d)", int(c))
+}
+
+// Parse sets c from s.
+func (c *Color) Parse(s string) error {
+	*c = ColorNone // Default unless overridden
+	switch s {
+	case "None":
+		*c = ColorNone
+	case "Red":
+		*c = ColorRed
+	case "Green":
+		*c = ColorGreen
+	default:
+		return fmt.Errorf("bad color %q", s)
+	}
+	return nil
+}
+
+var Default = ColorGreen
+`, "app/app.go", `package app
+
+import c "example.com/colors"
+
+type Mode uint8
+
+const (
+	ModeRead Mode = 1 << iota
+	ModeWrite
+)
+
+const all = ModeRead | ModeWrite // A constant expression, so Mode can't be migrated
+
+func Paint(col c.Color) bool { return col == c.ColorRed }
+`)
+
+	// Output:
+	// ==> app/app.go
+	// package app
+	//
+	// import c "example.com/colors"
+	//
+	// type Mode uint8
+	//
+	// const (
+	// 	ModeRead Mode = 1 << iota
+	// 	ModeWrite
+	// )
+	//
+	// const all = ModeRead | ModeWrite // A constant expression, so Mode can't be migrated
+	//
+	// func Paint(col c.Color) bool { return col == c.EColor.Red() }
+	// ==> colors/colors.go
+	// package colors
+	//
+	// import (
+	// 	"fmt"
+	// 	"reflect"
+	//
+	// 	"github.com/JeffreyRichter/enum/enum"
+	// )
+	//
+	// type Color int16
+	//
+	// var EColor = Color(0).None() // Helper variable used by consuming code (improves cross-package consumption)
+	//
+	// // Define Color's "symbols" and their values:
+	// func (Color) None() Color  { return Color(0) }
+	// func (Color) Red() Color   { return Color(1) }
+	// func (Color) Green() Color { return Color(2) } // The default
+	//
+	// // String converts c to its symbol.
+	// func (c Color) String() string {
+	// 	if symbol := enum.String(c, reflect.TypeOf(c)); symbol != "" {
+	// 		return symbol
+	// 	}
+	// 	return fmt.Sprintf("Color(%d)", int(c))
+	// }
+	//
+	// // Parse sets c from s.
+	// func (c *Color) Parse(s string) error {
+	// 	*c = EColor.None() // Default unless overridden
+	// 	v, err := enum.Parse(reflect.TypeOf(c), s, false)
+	// 	if err != nil {
+	// 		return fmt.Errorf("bad color %q", s)
+	// 	}
+	// 	*c = v.(Color)
+	// 	return nil
+	// }
+	//
+	// var Default = EColor.Green()
+	// app/app.go:12:13: Mode not migrated: ModeRead is used in a constant expression
+	// app/app.go:12:24: Mode not migrated: ModeWrite is used in a constant expression
+}
+
+// A bit flags type gets hex symbol values; a file without imports gets an import block
+func Example_flags() {
+	migrate("perm/perm.go", `package perm
+
+type Perm uint8
+
+const (
+	PermRead Perm = 1 << iota
+	PermWrite
+	PermExecute
+)
+
+func (p Perm) String() string {
+	switch p {
+	case PermRead:
+		return "Read"
+	case PermWrite:
+		return "Write"
+	case PermExecute:
+		return "Execute"
+	default:
+		return ""
+	}
+}
+
+func CanWrite(p Perm) bool { return p&PermWrite != 0 }
+`)
+
+	// Output:
+	// ==> perm/perm.go
+	// package perm
+	//
+	// import (
+	// 	"reflect"
+	//
+	// 	"github.com/JeffreyRichter/enum/enum"
+	// )
+	//
+	// type Perm uint8
+	//
+	// var EPerm = Perm(0).Read() // Helper variable used by consuming code (improves cross-package consumption)
+	//
+	// // Define Perm's "symbols" and their values:
+	// func (Perm) Read() Perm    { return Perm(0x01) }
+	// func (Perm) Write() Perm   { return Perm(0x02) }
+	// func (Perm) Execute() Perm { return Perm(0x04) }
+	//
+	// func (p Perm) String() string {
+	// 	return enum.String(p, reflect.TypeOf(p))
+	// }
+	//
+	// func CanWrite(p Perm) bool { return p&EPerm.Write() != 0 }
+}
+
+// An unexported type gets an unexported helper variable (its symbol methods are exported)
+func Example_unexported() {
+	migrate("log/log.go", `package log
+
+type level int
+
+const (
+	levelDebug level = iota
+	levelInfo
+)
+
+var current = levelInfo
+`)
+
+	// Output:
+	// ==> log/log.go
+	// package log
+	//
+	// type level int
+	//
+	// var eLevel = level(0).Debug() // Helper variable used by consuming code (improves cross-package consumption)
+	//
+	// // Define level's "symbols" and their values:
+	// func (level) Debug() level { return level(0) }
+	// func (level) Info() level  { return level(1) }
+	//
+	// var current = eLevel.Info()
+}
+
+// A blank constant skips a value
+func Example_blank() {
+	migrate("size/size.go", `package size
+
+type Size uint8
+
+const (
+	_ Size = iota // 0 means unknown
+	SizeSmall
+	_
+	SizeLarge
+)
+`)
+
+	// Output:
+	// ==> size/size.go
+	// package size
+	//
+	// type Size uint8
+	//
+	// var ESize = Size(0).Small() // Helper variable used by consuming code (improves cross-package consumption)
+	//
+	// // Define Size's "symbols" and their values:
+	// func (Size) Small() Size { return Size(1) }
+	// func (Size) Large() Size { return Size(3) }
+}
+
+// References in an external test package are migrated too
+func Example_externalTest() {
+	migrate("shape/shape.go", `package shape
+
+type Shape int
+
+const (
+	ShapeCircle Shape = iota
+	ShapeSquare
+)
+`, "shape/shape_test.go", `package shape_test
+
+import "example.com/shape"
+
+var s = shape.ShapeSquare
+`)
+
+	// Output:
+	// ==> shape/shape.go
+	// package shape
+	//
+	// type Shape int
+	//
+	// var EShape = Shape(0).Circle() // Helper variable used by consuming code (improves cross-package consumption)
+	//
+	// // Define Shape's "symbols" and their values:
+	// func (Shape) Circle() Shape { return Shape(0) }
+	// func (Shape) Square() Shape { return Shape(1) }
+	// ==> shape/shape_test.go
+	// package shape_test
+	//
+	// import "example.com/shape"
+	//
+	// var s = shape.EShape.Square()
+}
+
+// Types that can't be migrated are reported & left unchanged
+func Example_notMigrated() {
+	migrate("kinds/kinds.go", `package kinds
+
+type Size int
+
+const (
+	SizeSmall Size = iota
+	SizeLarge
+)
+
+var sizeNames = [...]string{SizeSmall: "small", SizeLarge: "large"} // Indexes must be constants
+
+type Fruit int
+
+const (
+	FruitApple Fruit = iota
+	FruitPear
+)
+
+var EFruit = "taken"
+
+type Dir int
+
+const (
+	DirUp Dir = iota
+	DirDown
+)
+
+func (d Dir) Up() Dir { return DirUp }
+
+type Turn int
+
+const (
+	TurnLeft Turn = iota
+	TurnRight
+)
+
+func (t Turn) Reverse() Turn { return 1 - t }
+
+type Pair int
+
+const (
+	PairA, PairB Pair = iota, iota + 10
+)
+
+type Odd int
+
+const (
+	OddOne Odd = iota + len("x")
+	OddTwo
+)
+`)
+
+	// Output:
+	// kinds/kinds.go:14:1: Fruit not migrated: EFruit is already declared
+	// kinds/kinds.go:42:2: Pair not migrated: a line declares 2 constants
+	// kinds/kinds.go:48:2: Odd not migrated: can't evaluate len("x")
+	// kinds/kinds.go:10:29: Size not migrated: SizeSmall is used in a constant expression
+	// kinds/kinds.go:10:49: Size not migrated: SizeLarge is used in a constant expression
+	// kinds/kinds.go:28:1: Dir not migrated: its Up method would conflict with the DirUp symbol
+	// kinds/kinds.go:37:1: Turn not migrated: its Reverse method would become a symbol
+}
+
+// String & Parse methods that aren't an exhaustive switch over the symbols are kept
+func Example_methodsKept() {
+	migrate("colors/colors.go", `package colors
+
+import "errors"
+
+type Color int
+
+const (
+	ColorNone Color = iota
+	ColorRed
+)
+
+func (c Color) String() string {
+	switch c {
+	case ColorRed:
+		return "red"
+	}
+	return "None"
+}
+
+func (c *Color) Parse(s string) error {
+	switch s {
+	case "Red":
+		*c = ColorRed
+	default:
+		return errors.New("bad color")
+	}
+	return nil
+}
+`)
+
+	// Output:
+	// ==> colors/colors.go
+	// package colors
+	//
+	// import "errors"
+	//
+	// type Color int
+	//
+	// var EColor = Color(0).None() // Helper variable used by consuming code (improves cross-package consumption)
+	//
+	// // Define Color's "symbols" and their values:
+	// func (Color) None() Color { return Color(0) }
+	// func (Color) Red() Color  { return Color(1) }
+	//
+	// func (c Color) String() string {
+	// 	switch c {
+	// 	case EColor.Red():
+	// 		return "red"
+	// 	}
+	// 	return "None"
+	// }
+	//
+	// func (c *Color) Parse(s string) error {
+	// 	switch s {
+	// 	case "Red":
+	// 		*c = EColor.Red()
+	// 	default:
+	// 		return errors.New("bad color")
+	// 	}
+	// 	return nil
+	// }
+	// colors/colors.go:12:1: Color's String method was kept because the switch has no case for ColorNone; replacing it could change its behavior
+	// colors/colors.go:20:1: Color's Parse method was kept because the switch has no case for ColorNone; replacing it could change its behavior
+}
+
+// Only the declarations that are migrated are formatted; the rest of a file is left as it was
+func Example_unformatted() {
+	migrate("sizes/sizes.go", "package sizes\n\ntype Size int\n\nconst (\n\tSizeSmall Size = iota\n\tSizeLarge\n)\n\n\n\nfunc unrelated()   {\n\tprintln(1)\t// Not gofmt-clean\n}\n\nvar big   =   SizeLarge\n")
+
+	// Output:
+	// ==> sizes/sizes.go
+	// package sizes
+	//
+	// type Size int
+	//
+	// var ESize = Size(0).Small() // Helper variable used by consuming code (improves cross-package consumption)
+	//
+	// // Define Size's "symbols" and their values:
+	// func (Size) Small() Size { return Size(0) }
+	// func (Size) Large() Size { return Size(1) }
+	//
+	// func unrelated()   {
+	// 	println(1)	// Not gofmt-clean
+	// }
+	//
+	// var big = ESize.Large()
+}
+
+// Nothing is changed if the migrated source doesn't type-check
+func Example_typeCheck() {
+	migrate("colors/colors.go", `package colors
+
+type Color int
+
+const (
+	ColorRed Color = iota
+	ColorBlue
+)
+
+type names [2]string
+
+var colorNames = names{ColorRed: "red", ColorBlue: "blue"} // A named array type's indexes must be constants
+`)
+
+	// Output:
+	// colors/colors.go:13:24: index EColor.Red() must be integer constant (in the migrated source)
+	// colors/colors.go:13:45: index EColor.Blue() must be integer constant (in the migrated source)
+	// nothing was changed: the migrated source doesn't type-check
+}
+
+func Example_unifiedDiff() {
+	fmt.Print(unifiedDiff("a.txt", "b.txt", []byte("1\n2\n3\n4\n5\n6\n7\n8\n9\n"), []byte("1\n2\n3\n4\nfive\n6\n7\n8\n9\n")))
+
+	// Output:
+	// --- a.txt
+	// +++ b.txt
+	// @@ -2,7 +2,7 @@
+	//  2
+	//  3
+	//  4
+	// -5
+	// +five
+	//  6
+	//  7
+	//  8
+}
@@ -0,0 +1,984 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// migration holds the parsed Go files being migrated and the problems found while migrating them.
+type migration struct {
+	fset     *token.FileSet
+	enumPkg  string    // The enum package's import path
+	files    []*goFile // In the order they were added
+	warnings []string
+}
+
+// goFile is a parsed Go file & the edits that migrate it.
+type goFile struct {
+	path       string
+	importPath string // The import path of the file's package ("" if unknown)
+	src        []byte
+	ast        *ast.File
+	edits      []edit
+	imports    map[string]bool // Import paths that the edits require
+}
+
+// edit replaces the source bytes [start, end) with text.
+type edit struct {
+	start, end int
+	text       string
+}
+
+// enumType is a const/iota enumerated type being migrated.
+type enumType struct {
+	name    string // The type's name (like Color)
+	helper  string // The helper variable's name (like EColor)
+	file    *goFile
+	block   *ast.GenDecl // The const block declaring the symbols
+	symbols []*symbol
+	consts  map[string]*symbol // Constant name -> symbol
+	flags   bool               // If true, the values are bit flags (the constants use <<)
+}
+
+// symbol is one of an enumType's constants.
+type symbol struct {
+	constName string // Like ColorRed
+	name      string // Like Red
+	value     int64
+	spec      *ast.ValueSpec
+}
+
+// newMigration returns a migration that rewrites enumerated types to use the enum package whose
+// import path is enumPkg.
+func newMigration(enumPkg string) *migration {
+	return &migration{fset: token.NewFileSet(), enumPkg: enumPkg}
+}
+
+// addFile parses a Go file (of the package whose import path is importPath) to be migrated.
+func (m *migration) addFile(path string, importPath string, src []byte) error {
+	f, err := parser.ParseFile(m.fset, path, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	m.files = append(m.files, &goFile{path: path, importPath: importPath, src: src, ast: f, imports: map[string]bool{}})
+	return nil
+}
+
+// source returns a file's original source.
+func (m *migration) source(path string) []byte {
+	for _, f := range m.files {
+		if f.path == path {
+			return f.src
+		}
+	}
+	return nil
+}
+
+// warnf records a problem found at pos.
+func (m *migration) warnf(pos token.Pos, format string, args ...interface{}) {
+	m.warnings = append(m.warnings, m.fset.Position(pos).String()+": "+fmt.Sprintf(format, args...))
+}
+
+// offset returns pos's byte offset within its file.
+func (m *migration) offset(pos token.Pos) int {
+	return m.fset.Position(pos).Offset
+}
+
+// packageKey returns the key identifying a file's package: its directory & package name.
+func packageKey(f *goFile) string {
+	return filepath.Dir(f.path) + "\x00" + f.ast.Name.Name
+}
+
+// rewrite migrates the enumerated types found in the added files & returns the new source of
+// each changed file (keyed by path). Nothing is changed (and an error is returned) if the migrated
+// source doesn't type-check.
+func (m *migration) rewrite() (map[string][]byte, error) {
+	packages := map[string][]*goFile{}
+	for _, f := range m.files {
+		packages[packageKey(f)] = append(packages[packageKey(f)], f)
+	}
+	for _, key := range sortedKeys(packages) {
+		for _, e := range m.findEnums(packages[key]) {
+			if m.checkReferences(e, packages[key]) {
+				m.migrateEnum(e, packages[key])
+			}
+		}
+	}
+
+	changed := map[string][]byte{}
+	for _, f := range m.files {
+		if len(f.edits) == 0 {
+			continue
+		}
+		m.addImports(f)
+		src, err := formatEdited(applyEdits(f.src, f.edits))
+		if err != nil {
+			return nil, fmt.Errorf("nothing was changed: the migrated source of %s is invalid: %v", f.path, err)
+		}
+		changed[f.path] = src
+	}
+	if len(changed) == 0 {
+		return changed, nil
+	}
+	if problems := m.newTypeErrors(packages, changed); len(problems) > 0 {
+		m.warnings = append(m.warnings, problems...)
+		return nil, errors.New("nothing was changed: the migrated source doesn't type-check")
+	}
+	return changed, nil
+}
+
+// findEnums returns a package's const/iota enumerated types: const blocks whose constants all
+// have the same integer type (declared in the package) & whose values use iota.
+func (m *migration) findEnums(files []*goFile) []*enumType {
+	integerTypes, declared := map[string]bool{}, map[string]bool{}
+	for _, f := range files {
+		for _, decl := range f.ast.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					declared[s.Name.Name] = true
+					if u, ok := s.Type.(*ast.Ident); ok && isInteger(u.Name) && s.TypeParams == nil {
+						integerTypes[s.Name.Name] = true
+					}
+				case *ast.ValueSpec:
+					for _, name := range s.Names {
+						declared[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	enums := []*enumType{}
+	for _, f := range files {
+		for _, decl := range f.ast.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST || !gd.Lparen.IsValid() {
+				continue
+			}
+			first := gd.Specs[0].(*ast.ValueSpec)
+			typ, ok := first.Type.(*ast.Ident)
+			if !ok || !integerTypes[typ.Name] || !usesIota(first.Values) {
+				continue // Not a const/iota enumerated type
+			}
+			e := m.parseBlock(f, gd, typ.Name)
+			if e == nil {
+				continue
+			}
+			if declared[e.helper] {
+				m.warnf(gd.Pos(), "%s not migrated: %s is already declared", e.name, e.helper)
+				continue
+			}
+			enums = append(enums, e)
+		}
+	}
+	return enums
+}
+
+// isInteger returns true if name is a predeclared integer type.
+func isInteger(name string) bool {
+	switch name {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "byte", "rune":
+		return true
+	}
+	return false
+}
+
+// usesIota returns true if any of exprs refers to iota.
+func usesIota(exprs []ast.Expr) bool {
+	found := false
+	for _, expr := range exprs {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && id.Name == "iota" {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// parseBlock returns the enumerated type declared by a const block (or nil if the block can't
+// be migrated).
+func (m *migration) parseBlock(f *goFile, gd *ast.GenDecl, typeName string) *enumType {
+	e := &enumType{name: typeName, helper: helperName(typeName), file: f, block: gd, consts: map[string]*symbol{}}
+	values := map[string]int64{} // Constant name -> value (for constants referring to earlier ones)
+	var expr ast.Expr
+	for iota, spec := range gd.Specs {
+		vs := spec.(*ast.ValueSpec)
+		if len(vs.Names) != 1 {
+			m.warnf(vs.Pos(), "%s not migrated: a line declares %d constants", typeName, len(vs.Names))
+			return nil
+		}
+		if vs.Values != nil {
+			if typ, ok := vs.Type.(*ast.Ident); !ok || typ.Name != typeName {
+				m.warnf(vs.Pos(), "%s not migrated: constant %s isn't a %s", typeName, vs.Names[0].Name, typeName)
+				return nil
+			}
+			expr = vs.Values[0]
+		}
+		value, err := evaluate(expr, int64(iota), typeName, values)
+		if err != nil {
+			m.warnf(vs.Pos(), "%s not migrated: %v", typeName, err)
+			return nil
+		}
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if be, ok := n.(*ast.BinaryExpr); ok && be.Op == token.SHL {
+				e.flags = true
+			}
+			return true
+		})
+		name := vs.Names[0].Name
+		values[name] = value
+		if name == "_" {
+			continue // A blank constant skips a value
+		}
+		s := &symbol{constName: name, value: value, spec: vs}
+		e.symbols = append(e.symbols, s)
+		e.consts[name] = s
+	}
+	constNames := make([]string, len(e.symbols))
+	for i, s := range e.symbols {
+		constNames[i] = s.constName
+	}
+	for i, name := range symbolNames(constNames, typeName) {
+		e.symbols[i].name = name
+	}
+	return e
+}
+
+// evaluate returns the value of a constant expression of an enumerated type.
+func evaluate(expr ast.Expr, iota int64, typeName string, values map[string]int64) (int64, error) {
+	switch x := expr.(type) {
+	case *ast.BasicLit:
+		if x.Kind == token.INT {
+			return strconv.ParseInt(x.Value, 0, 64)
+		}
+	case *ast.Ident:
+		if x.Name == "iota" {
+			return iota, nil
+		}
+		if v, ok := values[x.Name]; ok {
+			return v, nil
+		}
+	case *ast.ParenExpr:
+		return evaluate(x.X, iota, typeName, values)
+	case *ast.CallExpr: // A conversion like Color(iota)
+		if fun, ok := x.Fun.(*ast.Ident); ok && (fun.Name == typeName || isInteger(fun.Name)) && len(x.Args) == 1 {
+			return evaluate(x.Args[0], iota, typeName, values)
+		}
+	case *ast.UnaryExpr:
+		v, err := evaluate(x.X, iota, typeName, values)
+		if err != nil {
+			return 0, err
+		}
+		switch x.Op {
+		case token.SUB:
+			return -v, nil
+		case token.ADD:
+			return v, nil
+		case token.XOR:
+			return ^v, nil
+		}
+	case *ast.BinaryExpr:
+		a, err := evaluate(x.X, iota, typeName, values)
+		if err != nil {
+			return 0, err
+		}
+		b, err := evaluate(x.Y, iota, typeName, values)
+		if err != nil {
+			return 0, err
+		}
+		switch x.Op {
+		case token.ADD:
+			return a + b, nil
+		case token.SUB:
+			return a - b, nil
+		case token.MUL:
+			return a * b, nil
+		case token.QUO, token.REM:
+			if b == 0 {
+				return 0, fmt.Errorf("division by zero")
+			}
+			if x.Op == token.QUO {
+				return a / b, nil
+			}
+			return a % b, nil
+		case token.SHL:
+			return a << uint64(b), nil
+		case token.SHR:
+			return a >> uint64(b), nil
+		case token.OR:
+			return a | b, nil
+		case token.AND:
+			return a & b, nil
+		case token.AND_NOT:
+			return a &^ b, nil
+		}
+	}
+	return 0, fmt.Errorf("can't evaluate %s", nodeString(expr))
+}
+
+// nodeString returns the Go source for an AST node.
+func nodeString(n ast.Node) string {
+	b := &bytes.Buffer{}
+	format.Node(b, token.NewFileSet(), n)
+	return b.String()
+}
+
+// helperName returns the name of the helper variable for an enumerated type (like EColor).
+func helperName(typeName string) string {
+	if r, _ := utf8.DecodeRuneInString(typeName); unicode.IsUpper(r) {
+		return "E" + typeName
+	}
+	return "e" + capitalize(typeName) // Keep an unexported type's helper unexported
+}
+
+// capitalize returns s with its first letter in upper case.
+func capitalize(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
+
+// symbolNames returns the symbol method names for constants by removing their common prefix
+// (like Color in ColorNone & ColorRed) so that each name still starts with an upper case letter.
+func symbolNames(constNames []string, typeName string) []string {
+	prefix := typeName // A lone constant's prefix is the type's name (if it starts with it)
+	if len(constNames) > 1 {
+		prefix = constNames[0]
+	}
+	for _, name := range constNames {
+		for !strings.HasPrefix(name, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	for ; prefix != ""; prefix = prefix[:len(prefix)-1] {
+		ok := true
+		for _, name := range constNames {
+			r, _ := utf8.DecodeRuneInString(name[len(prefix):])
+			ok = ok && unicode.IsUpper(r)
+		}
+		if ok {
+			break
+		}
+	}
+	names := make([]string, len(constNames))
+	for i, name := range constNames {
+		names[i] = capitalize(name[len(prefix):]) // Symbol methods must be exported
+	}
+	return names
+}
+
+// walk calls f for each node in root with the node's ancestors (the last is its parent).
+func walk(root ast.Node, f func(n ast.Node, ancestors []ast.Node)) {
+	stack := []ast.Node{}
+	ast.Inspect(root, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return false
+		}
+		f(n, stack)
+		stack = append(stack, n)
+		return true
+	})
+}
+
+// reference is a use of an enumType's constant.
+type reference struct {
+	file   *goFile
+	ident  *ast.Ident // The constant's name (the Sel of a qualified reference like colors.ColorRed)
+	symbol *symbol
+}
+
+// references returns the uses of e's constants outside of its const block; pkgFiles are the
+// files of e's package.
+func (m *migration) references(e *enumType, pkgFiles []*goFile) []reference {
+	inPackage := map[*goFile]bool{}
+	for _, f := range pkgFiles {
+		inPackage[f] = true
+	}
+	refs := []reference{}
+	for _, f := range m.files {
+		importName := ""
+		if !inPackage[f] {
+			if importName = importedAs(f.ast, e.file.importPath); importName == "" {
+				continue // The file can't refer to e's constants
+			}
+		}
+		walk(f.ast, func(n ast.Node, ancestors []ast.Node) {
+			id, ok := n.(*ast.Ident)
+			if !ok || e.consts[id.Name] == nil || (f == e.file && id.Pos() >= e.block.Pos() && id.End() <= e.block.End()) {
+				return
+			}
+			parent := ancestors[len(ancestors)-1]
+			if sel, ok := parent.(*ast.SelectorExpr); ok && sel.Sel == id {
+				if x, ok := sel.X.(*ast.Ident); !ok || x.Name != importName || x.Obj != nil || inPackage[f] {
+					return // A field or method (or not e's package)
+				}
+			} else if !inPackage[f] || (id.Obj != nil && id.Obj.Decl != e.consts[id.Name].spec) {
+				return // Another package's identifier or a local identifier hiding the constant
+			}
+			refs = append(refs, reference{f, id, e.consts[id.Name]})
+		})
+	}
+	return refs
+}
+
+// importedAs returns the name by which a file refers to the package whose import path is
+// importPath ("" if the file doesn't import it).
+func importedAs(f *ast.File, importPath string) string {
+	if importPath == "" {
+		return ""
+	}
+	for _, spec := range f.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == importPath {
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+			return path.Base(importPath)
+		}
+	}
+	return ""
+}
+
+// checkReferences returns true if none of e's constants are used in constant expressions, array
+// lengths or array literal indexes (which can't call symbol methods); otherwise, the uses are
+// reported. Uses this misses (like indexes in a literal of a named array type) are found when the
+// migrated source is type-checked.
+func (m *migration) checkReferences(e *enumType, pkgFiles []*goFile) bool {
+	ok := true
+	for _, ref := range m.references(e, pkgFiles) {
+		walk(ref.file.ast, func(n ast.Node, ancestors []ast.Node) {
+			if n != ref.ident {
+				return
+			}
+			for i, a := range ancestors {
+				child := n // The node within a that contains the reference
+				if i+1 < len(ancestors) {
+					child = ancestors[i+1]
+				}
+				constant := false
+				switch a := a.(type) {
+				case *ast.GenDecl:
+					constant = a.Tok == token.CONST
+				case *ast.ArrayType:
+					constant = child == a.Len
+				case *ast.KeyValueExpr: // An index in an array or slice literal
+					if lit, ok := ancestors[i-1].(*ast.CompositeLit); ok && child == a.Key {
+						_, constant = lit.Type.(*ast.ArrayType)
+					}
+				}
+				if constant {
+					m.warnf(ref.ident.Pos(), "%s not migrated: %s is used in a constant expression", e.name, ref.ident.Name)
+					ok = false
+					return
+				}
+			}
+		})
+	}
+	return ok
+}
+
+// migrateEnum records the edits that replace e's const block, its hand-written String & Parse
+// methods & the references to its constants.
+func (m *migration) migrateEnum(e *enumType, pkgFiles []*goFile) {
+	methods := map[string]*ast.FuncDecl{}
+	for _, f := range pkgFiles {
+		for _, decl := range f.ast.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv != nil && receiverType(fd) == e.name {
+				methods[fd.Name.Name] = fd
+			}
+		}
+	}
+	for _, s := range e.symbols {
+		if fd := methods[s.name]; fd != nil {
+			m.warnf(fd.Pos(), "%s not migrated: its %s method would conflict with the %s symbol", e.name, s.name, s.constName)
+			return
+		}
+	}
+	for _, name := range sortedKeys(methods) {
+		if fd := methods[name]; returnsOwnType(e, fd) {
+			m.warnf(fd.Pos(), "%s not migrated: its %s method would become a symbol", e.name, name)
+			return
+		}
+	}
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "var %s = %s(0).%s() // Helper variable used by consuming code (improves cross-package consumption)\n\n",
+		e.helper, e.name, e.symbols[0].name)
+	if doc := e.symbols[0].spec.Doc; doc == nil || !strings.HasPrefix(doc.Text(), "Define ") {
+		fmt.Fprintf(b, "// Define %s's \"symbols\" and their values:\n", e.name)
+	}
+	for _, s := range e.symbols {
+		if s.spec.Doc != nil {
+			b.WriteString(m.text(e.file, s.spec.Doc) + "\n")
+		}
+		value := strconv.FormatInt(s.value, 10)
+		if e.flags {
+			value = fmt.Sprintf("0x%02x", s.value)
+		}
+		fmt.Fprintf(b, "func (%s) %s() %s { return %s(%s) }", e.name, s.name, e.name, e.name, value)
+		if s.spec.Comment != nil {
+			b.WriteString(" " + m.text(e.file, s.spec.Comment))
+		}
+		b.WriteString("\n")
+	}
+	e.file.edits = append(e.file.edits, edit{m.offset(e.block.Pos()), m.offset(e.block.End()), b.String()})
+
+	for _, f := range pkgFiles {
+		for _, decl := range f.ast.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || receiverType(fd) != e.name || !m.switchesOver(e, fd) {
+				continue
+			}
+			ed, why := m.replaceSwitch(e, f, fd)
+			if why != "" {
+				m.warnf(fd.Pos(), "%s's %s method was kept because %s; replacing it could change its behavior", e.name, fd.Name.Name, why)
+				continue
+			}
+			f.edits = append(f.edits, ed)
+			f.imports["reflect"], f.imports[m.enumPkg] = true, true
+		}
+	}
+
+	for _, ref := range m.references(e, pkgFiles) {
+		start, end := m.offset(ref.ident.Pos()), m.offset(ref.ident.End())
+		if covered(ref.file.edits, start, end) {
+			continue // The reference is in a replaced method
+		}
+		ref.file.edits = append(ref.file.edits, edit{start, end, fmt.Sprintf("%s.%s()", e.helper, ref.symbol.name)})
+	}
+}
+
+// covered returns true if [start, end) is within one of edits' ranges.
+func covered(edits []edit, start int, end int) bool {
+	for _, ed := range edits {
+		if start >= ed.start && end <= ed.end {
+			return true
+		}
+	}
+	return false
+}
+
+// text returns the source of a node in f.
+func (m *migration) text(f *goFile, n ast.Node) string {
+	return string(f.src[m.offset(n.Pos()):m.offset(n.End())])
+}
+
+// receiverType returns the name of a method's receiver type (without any *).
+func receiverType(fd *ast.FuncDecl) string {
+	t := fd.Recv.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// switchesOver returns true if a method has a switch statement & uses e's constants (like a
+// hand-written String or Parse method).
+func (m *migration) switchesOver(e *enumType, fd *ast.FuncDecl) bool {
+	switches, uses := false, false
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.SwitchStmt:
+			switches = true
+		case *ast.Ident:
+			uses = uses || e.consts[x.Name] != nil
+		}
+		return !(switches && uses)
+	})
+	return switches && uses
+}
+
+// sortedKeys returns a map's keys in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// returnsOwnType returns true if fd is an exported value method that takes no arguments &
+// returns e's type; the enum package would consider it a symbol.
+func returnsOwnType(e *enumType, fd *ast.FuncDecl) bool {
+	if _, pointer := fd.Recv.List[0].Type.(*ast.StarExpr); pointer || !fd.Name.IsExported() || len(fd.Type.Params.List) > 0 {
+		return false
+	}
+	results := fd.Type.Results
+	if results == nil || len(results.List) != 1 || len(results.List[0].Names) > 1 {
+		return false
+	}
+	typ, ok := results.List[0].Type.(*ast.Ident)
+	return ok && typ.Name == e.name
+}
+
+// replaceSwitch returns the edit replacing the switch in a hand-written String or Parse method
+// with a call to the enum package. The method must switch exhaustively over e's symbols exactly
+// like this (the reset of *c is optional):
+//
+//	func (c Color) String() string {        func (c *Color) Parse(s string) error {
+//	   switch c {                              *c = ColorNone
+//	   case ColorRed:                          switch s {
+//	      return "Red"                         case "Red":
+//	   ...                                        *c = ColorRed
+//	   default:                                ...
+//	      return "Unknown"                     default:
+//	   }                                          return fmt.Errorf("bad color %q", s)
+//	}                                          }
+//	                                           return nil
+//	                                        }
+//
+// so that the replacement behaves identically (the default's result is kept); otherwise, why
+// explains the mismatch.
+func (m *migration) replaceSwitch(e *enumType, f *goFile, fd *ast.FuncDecl) (ed edit, why string) {
+	names := fd.Recv.List[0].Names
+	if len(names) != 1 || names[0].Name == "_" {
+		return edit{}, "its receiver has no name"
+	}
+	recv := names[0].Name
+	_, pointer := fd.Recv.List[0].Type.(*ast.StarExpr)
+	pkg, reflectPkg := importedAs(f.ast, m.enumPkg), importedAs(f.ast, "reflect")
+	if pkg == "" {
+		pkg = path.Base(m.enumPkg)
+	}
+	if reflectPkg == "" {
+		reflectPkg = "reflect"
+	}
+	params, results := fd.Type.Params.List, fd.Type.Results
+	body := fd.Body.List
+	switch {
+	case fd.Name.Name == "String" && !pointer && len(params) == 0 && results != nil && len(results.List) == 1:
+		if uses(fd, "symbol") {
+			return edit{}, "it uses the name symbol"
+		}
+		if len(body) == 0 {
+			return edit{}, "it has no switch"
+		}
+		sw, ok := body[0].(*ast.SwitchStmt)
+		if !ok || len(body) > 2 || sw.Init != nil || !isIdent(sw.Tag, recv) {
+			return edit{}, fmt.Sprintf("it isn't just a switch over %s", recv)
+		}
+		cases := map[*symbol]string{} // Symbol -> the string literal its case returns
+		var fallback ast.Expr
+		for _, stmt := range sw.Body.List {
+			clause := stmt.(*ast.CaseClause)
+			ret, ok := onlyStmt(clause.Body).(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				return edit{}, "a case doesn't just return a string"
+			}
+			if clause.List == nil {
+				fallback = ret.Results[0]
+				continue
+			}
+			if len(clause.List) != 1 {
+				return edit{}, "a case has several values"
+			}
+			id, _ := clause.List[0].(*ast.Ident)
+			lit, _ := ret.Results[0].(*ast.BasicLit)
+			if id == nil || e.consts[id.Name] == nil || lit == nil || lit.Kind != token.STRING {
+				return edit{}, "a case doesn't return a string literal for a constant"
+			}
+			cases[e.consts[id.Name]], _ = strconv.Unquote(lit.Value)
+		}
+		if len(body) == 2 { // The switch has no default; the result follows the switch
+			ret, ok := body[1].(*ast.ReturnStmt)
+			if !ok || fallback != nil || len(ret.Results) != 1 {
+				return edit{}, fmt.Sprintf("it isn't just a switch over %s", recv)
+			}
+			fallback = ret.Results[0]
+		}
+		if why := exhaustive(e, cases); why != "" {
+			return edit{}, why
+		}
+		if fallback == nil {
+			return edit{}, "the switch has no default"
+		}
+		if m.usesConstants(e, fallback) {
+			return edit{}, "its default result uses a constant"
+		}
+		text := fmt.Sprintf("return %s.String(%s, %s.TypeOf(%s))", pkg, recv, reflectPkg, recv)
+		if lit, ok := fallback.(*ast.BasicLit); !ok || lit.Value != `""` {
+			text = fmt.Sprintf("if symbol := %s.String(%s, %s.TypeOf(%s)); symbol != \"\" {\nreturn symbol\n}\nreturn %s",
+				pkg, recv, reflectPkg, recv, m.text(f, fallback))
+		}
+		return edit{m.offset(sw.Pos()), m.offset(body[len(body)-1].End()), text}, ""
+
+	case fd.Name.Name == "Parse" && pointer && len(params) == 1 && len(params[0].Names) == 1 && results != nil && len(results.List) == 1:
+		s := params[0].Names[0].Name
+		if uses(fd, "v") || uses(fd, "err") {
+			return edit{}, "it uses the name v or err"
+		}
+		if len(body) == 3 && isSymbolAssign(e, body[0], recv) { // *c = ColorNone resets c
+			body = body[1:]
+		}
+		if len(body) != 2 {
+			return edit{}, fmt.Sprintf("it isn't just a switch over %s", s)
+		}
+		sw, ok := body[0].(*ast.SwitchStmt)
+		ret, _ := body[1].(*ast.ReturnStmt)
+		if !ok || sw.Init != nil || !isIdent(sw.Tag, s) || ret == nil || len(ret.Results) != 1 || !isIdent(ret.Results[0], "nil") {
+			return edit{}, fmt.Sprintf("it isn't just a switch over %s followed by return nil", s)
+		}
+		cases := map[*symbol]string{} // Symbol -> the string literal of its case
+		var fallback ast.Expr
+		for _, stmt := range sw.Body.List {
+			clause := stmt.(*ast.CaseClause)
+			if clause.List == nil {
+				ret, ok := onlyStmt(clause.Body).(*ast.ReturnStmt)
+				if !ok || len(ret.Results) != 1 {
+					return edit{}, "its default doesn't just return an error"
+				}
+				fallback = ret.Results[0]
+				continue
+			}
+			lit, _ := clause.List[0].(*ast.BasicLit)
+			if len(clause.List) != 1 || lit == nil || lit.Kind != token.STRING || !isSymbolAssign(e, onlyStmt(clause.Body), recv) {
+				return edit{}, "a case doesn't just set a constant for a string literal"
+			}
+			cases[e.consts[onlyStmt(clause.Body).(*ast.AssignStmt).Rhs[0].(*ast.Ident).Name]], _ = strconv.Unquote(lit.Value)
+		}
+		if why := exhaustive(e, cases); why != "" {
+			return edit{}, why
+		}
+		if fallback == nil {
+			return edit{}, "the switch has no default"
+		}
+		if m.usesConstants(e, fallback) {
+			return edit{}, "its default result uses a constant"
+		}
+		text := fmt.Sprintf("v, err := %s.Parse(%s.TypeOf(%s), %s, false)\nif err != nil {\nreturn %s\n}\n*%s = v.(%s)",
+			pkg, reflectPkg, recv, s, m.text(f, fallback), recv, e.name)
+		return edit{m.offset(sw.Pos()), m.offset(sw.End()), text}, ""
+	}
+	return edit{}, "it isn't a String or Parse method"
+}
+
+// exhaustive returns why the cases of a switch (symbol -> the string literal of its case) don't
+// match e's symbols exactly ("" if they do).
+func exhaustive(e *enumType, cases map[*symbol]string) string {
+	for _, s := range e.symbols {
+		literal, ok := cases[s]
+		switch {
+		case !ok:
+			return fmt.Sprintf("the switch has no case for %s", s.constName)
+		case literal != s.name:
+			return fmt.Sprintf("the case for %s uses %q instead of the symbol name %q", s.constName, literal, s.name)
+		}
+	}
+	return ""
+}
+
+// onlyStmt returns the statement in stmts if there's exactly one (else nil).
+func onlyStmt(stmts []ast.Stmt) ast.Stmt {
+	if len(stmts) != 1 {
+		return nil
+	}
+	return stmts[0]
+}
+
+// isIdent returns true if expr is the identifier name.
+func isIdent(expr ast.Expr, name string) bool {
+	id, ok := expr.(*ast.Ident)
+	return ok && id.Name == name
+}
+
+// isSymbolAssign returns true if stmt assigns one of e's constants to *recv (like *c = ColorRed).
+func isSymbolAssign(e *enumType, stmt ast.Stmt, recv string) bool {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return false
+	}
+	star, ok := assign.Lhs[0].(*ast.StarExpr)
+	id, _ := assign.Rhs[0].(*ast.Ident)
+	return ok && isIdent(star.X, recv) && id != nil && e.consts[id.Name] != nil
+}
+
+// uses returns true if the identifier name appears in n.
+func uses(n ast.Node, name string) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// usesConstants returns true if expr refers to any of e's constants.
+func (m *migration) usesConstants(e *enumType, expr ast.Expr) bool {
+	for _, s := range e.symbols {
+		if uses(expr, s.constName) {
+			return true
+		}
+	}
+	return false
+}
+
+// addImports records edits adding the imports that f's edits require; standard library packages
+// are added to the first import group & other packages to a new group.
+func (m *migration) addImports(f *goFile) {
+	std, other := []string{}, []string{}
+	for p := range f.imports {
+		switch {
+		case importedAs(f.ast, p) != "":
+		case strings.Contains(strings.Split(p, "/")[0], "."):
+			other = append(other, strconv.Quote(p))
+		default:
+			std = append(std, strconv.Quote(p))
+		}
+	}
+	if len(std)+len(other) == 0 {
+		return
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	specs := func(existing string) string {
+		groups := []string{}
+		for _, group := range [][]string{std, other} {
+			if len(group) > 0 {
+				groups = append(groups, "\t"+strings.Join(group, "\n\t"))
+			}
+		}
+		if existing != "" {
+			groups[0] = "\t" + existing + "\n" + groups[0] // gofmt sorts the group
+		}
+		return strings.Join(groups, "\n\n")
+	}
+	for _, decl := range f.ast.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		if gd.Lparen.IsValid() {
+			at := m.offset(gd.Rparen)
+			f.edits = append(f.edits, edit{at, at, "\n" + specs("") + "\n"})
+		} else { // Convert the single import to a block
+			f.edits = append(f.edits, edit{m.offset(gd.Pos()), m.offset(gd.End()), "import (\n" + specs(m.text(f, gd.Specs[0])) + "\n)"})
+		}
+		return
+	}
+	at := m.offset(f.ast.Name.End())
+	f.edits = append(f.edits, edit{at, at, "\n\nimport (\n" + specs("") + "\n)"})
+}
+
+// applyEdits returns src with edits applied & the edited byte ranges ([start, end) within the
+// returned source); overlapping edits are skipped.
+func applyEdits(src []byte, edits []edit) ([]byte, [][2]int) {
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	b, at, edited := &bytes.Buffer{}, 0, [][2]int{}
+	for _, ed := range edits {
+		if ed.start < at {
+			continue // Overlaps the previous edit
+		}
+		b.Write(src[at:ed.start])
+		edited = append(edited, [2]int{b.Len(), b.Len() + len(ed.text)})
+		b.WriteString(ed.text)
+		at = ed.end
+	}
+	b.Write(src[at:])
+	return b.Bytes(), edited
+}
+
+// formatEdited returns src with the top-level declarations that overlap the edited byte ranges
+// formatted by gofmt; the rest of src (which may not have been gofmt-clean) is left as it was.
+// Adjacent edited declarations are formatted together so that gofmt aligns them (like the
+// one-line symbol methods).
+func formatEdited(src []byte, edited [][2]int) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	chunks := [][2]int{} // The byte ranges to format
+	for _, decl := range file.Decls {
+		start, end := fset.Position(decl.Pos()).Offset, fset.Position(decl.End()).Offset
+		if doc := declDoc(decl); doc != nil {
+			start = fset.Position(doc.Pos()).Offset
+		}
+		if eol := bytes.IndexByte(src[end:], '\n'); eol >= 0 {
+			end += eol // Include a trailing comment
+		} else {
+			end = len(src)
+		}
+		if !overlaps(start, end, edited) {
+			continue
+		}
+		if n := len(chunks); n > 0 && len(bytes.TrimSpace(src[chunks[n-1][1]:start])) == 0 {
+			chunks[n-1][1] = end // Only whitespace separates it from the previous edited declaration
+			continue
+		}
+		chunks = append(chunks, [2]int{start, end})
+	}
+	for i := len(chunks) - 1; i >= 0; i-- { // Replace from the end so the earlier offsets stay valid
+		formatted, err := format.Source(src[chunks[i][0]:chunks[i][1]])
+		if err != nil {
+			return nil, err
+		}
+		// Like gofmt, separate the chunk from its neighbors by at most 1 blank line
+		start := len(bytes.TrimRightFunc(src[:chunks[i][0]], unicode.IsSpace))
+		end := len(src) - len(bytes.TrimLeftFunc(src[chunks[i][1]:], unicode.IsSpace))
+		before, after := blankLines(src[start:chunks[i][0]], start > 0), blankLines(src[chunks[i][1]:end], end < len(src))
+		if end == len(src) {
+			after = "\n" // A file ends with a single newline
+		}
+		src = slices.Concat(src[:start], []byte(before), formatted, []byte(after), src[end:])
+	}
+	return src, nil
+}
+
+// blankLines returns the newlines that separate a declaration from a neighbor (if any) in place
+// of the whitespace between them: 1 newline or, if space has blank lines, 2.
+func blankLines(space []byte, neighbor bool) string {
+	switch n := bytes.Count(space, []byte("\n")); {
+	case !neighbor:
+		return ""
+	case n <= 1:
+		return "\n"
+	default:
+		return "\n\n"
+	}
+}
+
+// declDoc returns a top-level declaration's doc comment (nil if it has none).
+func declDoc(decl ast.Decl) *ast.CommentGroup {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return d.Doc
+	case *ast.GenDecl:
+		return d.Doc
+	}
+	return nil
+}
+
+// overlaps returns true if [start, end] contains or overlaps any of the ranges.
+func overlaps(start int, end int, ranges [][2]int) bool {
+	for _, r := range ranges {
+		if r[0] <= end && r[1] >= start {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// external imports the packages that aren't being migrated from their source (so they needn't be
+// built); it's shared so that each package is only type-checked once.
+var external = importer.ForCompiler(token.NewFileSet(), "source", nil).(types.ImporterFrom)
+
+// typeChecker type-checks the packages being migrated using either their original or their
+// migrated source; it imports a package being migrated (without its _test.go files) from the
+// same source.
+type typeChecker struct {
+	fset     *token.FileSet
+	src      func(f *goFile) []byte    // Returns the source to check for a file
+	byPath   map[string][]*goFile      // Import path -> the files of the package being migrated
+	imported map[string]*types.Package // Import path -> package (nil while it's being checked)
+}
+
+// newTypeChecker returns a typeChecker for the packages (keyed by packageKey) whose files have
+// the source returned by src.
+func newTypeChecker(packages map[string][]*goFile, src func(f *goFile) []byte) *typeChecker {
+	tc := &typeChecker{fset: token.NewFileSet(), src: src, byPath: map[string][]*goFile{}, imported: map[string]*types.Package{}}
+	for _, files := range packages {
+		if files[0].importPath == "" || strings.HasSuffix(files[0].ast.Name.Name, "_test") {
+			continue // Other packages can't import it
+		}
+		for _, f := range files {
+			if !strings.HasSuffix(f.path, "_test.go") {
+				tc.byPath[f.importPath] = append(tc.byPath[f.importPath], f)
+			}
+		}
+	}
+	return tc
+}
+
+// Import imports the package whose import path is path.
+func (tc *typeChecker) Import(path string) (*types.Package, error) {
+	return tc.ImportFrom(path, ".", 0)
+}
+
+// ImportFrom imports the package whose import path is path (from a package in dir).
+func (tc *typeChecker) ImportFrom(path string, dir string, mode types.ImportMode) (*types.Package, error) {
+	files, ok := tc.byPath[path]
+	if !ok {
+		if _, err := os.Stat(dir); err != nil {
+			dir = "." // The directory of a package added from memory may not exist
+		}
+		return external.ImportFrom(path, dir, mode)
+	}
+	if pkg, checked := tc.imported[path]; checked {
+		if pkg == nil {
+			return nil, fmt.Errorf("import cycle via %s", path)
+		}
+		return pkg, nil
+	}
+	tc.imported[path] = nil
+	pkg, _ := tc.check(path, files) // The errors are reported when the package itself is checked
+	tc.imported[path] = pkg
+	return pkg, nil
+}
+
+// check returns the package whose files are files & its type errors.
+func (tc *typeChecker) check(importPath string, files []*goFile) (*types.Package, []types.Error) {
+	errs := []types.Error{}
+	astFiles := []*ast.File{}
+	for _, f := range files {
+		file, err := parser.ParseFile(tc.fset, f.path, tc.src(f), 0)
+		if err != nil {
+			errs = append(errs, types.Error{Fset: tc.fset, Msg: err.Error()})
+			continue
+		}
+		astFiles = append(astFiles, file)
+	}
+	conf := types.Config{Importer: tc, Error: func(err error) { errs = append(errs, err.(types.Error)) }}
+	if importPath == "" {
+		importPath = filepath.Dir(files[0].path)
+	}
+	pkg, _ := conf.Check(importPath, tc.fset, astFiles, nil)
+	return pkg, errs
+}
+
+// newTypeErrors returns the type errors that the migrated source (changed, keyed by path) of the
+// packages (keyed by packageKey) has but the original source doesn't.
+func (m *migration) newTypeErrors(packages map[string][]*goFile, changed map[string][]byte) []string {
+	original := newTypeChecker(packages, func(f *goFile) []byte { return f.src })
+	migrated := newTypeChecker(packages, func(f *goFile) []byte {
+		if src, ok := changed[f.path]; ok {
+			return src
+		}
+		return f.src
+	})
+	problems := []string{}
+	for _, key := range sortedKeys(packages) {
+		files := packages[key]
+		_, before := original.check(files[0].importPath, files)
+		_, after := migrated.check(files[0].importPath, files)
+		known := map[string]bool{} // Errors the original source has too (like a missing dependency)
+		for _, err := range before {
+			known[err.Msg] = true
+		}
+		for _, err := range after {
+			if !known[err.Msg] {
+				problems = append(problems, err.Error()+" (in the migrated source)")
+			}
+		}
+	}
+	return problems
+}
@@ -0,0 +1,195 @@
+package enum
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strings"
+)
+
+// Integer is satisfied by enum types whose underlying type is any integer kind.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | Unsigned
+}
+
+// MaxBigFlagsBit is the largest bit number a BigFlags can hold; ParseBigFlags (and so
+// UnmarshalText & JSON unmarshaling) rejects larger bit numbers so that untrusted input can't
+// make a BigFlags grow without bound.
+const MaxBigFlagsBit = 1<<16 - 1
+
+// BigFlags is a set of bit flags for enum types with more than 64 flags; it is backed by a
+// []uint64 that grows as needed. T's symbols are bit numbers (0 through MaxBigFlagsBit) rather
+// than bit masks. For example:
+//
+//	type Capability uint16
+//	func (Capability) Read() Capability  { return Capability(0) }
+//	func (Capability) Write() Capability { return Capability(1) }
+//	...
+//	func (Capability) Audit() Capability { return Capability(99) }
+//
+//	var caps enum.BigFlags[Capability]
+//	caps.Set(ECapability.Read(), ECapability.Audit())
+type BigFlags[T Integer] struct {
+	words []uint64 // Bit n is bit n%64 of words[n/64]
+}
+
+// NewBigFlags returns a BigFlags with the specified bits set.
+func NewBigFlags[T Integer](bits ...T) BigFlags[T] {
+	f := BigFlags[T]{}
+	f.Set(bits...)
+	return f
+}
+
+// Has returns true if bit is set in f.
+func (f BigFlags[T]) Has(bit T) bool {
+	if !validBigFlagsBit(bit) {
+		return false // The bit can't be set
+	}
+	word, mask := bigFlagsBit(bit)
+	return word < len(f.words) && f.words[word]&mask != 0
+}
+
+// Set sets the specified bits in f; it panics if a bit is negative or greater than MaxBigFlagsBit.
+func (f *BigFlags[T]) Set(bits ...T) {
+	for _, bit := range bits {
+		word, mask := bigFlagsBit(bit)
+		for len(f.words) <= word {
+			f.words = append(f.words, 0) // Grow to hold the bit
+		}
+		f.words[word] |= mask
+	}
+}
+
+// Clear clears the specified bits in f.
+func (f *BigFlags[T]) Clear(bits ...T) {
+	for _, bit := range bits {
+		if !validBigFlagsBit(bit) {
+			continue // The bit can't be set
+		}
+		if word, mask := bigFlagsBit(bit); word < len(f.words) {
+			f.words[word] &^= mask
+		}
+	}
+}
+
+// Union returns the bits set in f or f2.
+func (f BigFlags[T]) Union(f2 BigFlags[T]) BigFlags[T] {
+	u := BigFlags[T]{words: append([]uint64(nil), f.words...)}
+	for i, w := range f2.words {
+		if i < len(u.words) {
+			u.words[i] |= w
+		} else {
+			u.words = append(u.words, w)
+		}
+	}
+	return u
+}
+
+// Intersect returns the bits set in both f and f2.
+func (f BigFlags[T]) Intersect(f2 BigFlags[T]) BigFlags[T] {
+	i := BigFlags[T]{words: make([]uint64, min(len(f.words), len(f2.words)))}
+	for n := range i.words {
+		i.words[n] = f.words[n] & f2.words[n]
+	}
+	return i
+}
+
+// Equal returns true if f and f2 have the same bits set.
+func (f BigFlags[T]) Equal(f2 BigFlags[T]) bool {
+	for i := 0; i < max(len(f.words), len(f2.words)); i++ {
+		if f.word(i) != f2.word(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of bits set in f.
+func (f BigFlags[T]) Len() int {
+	n := 0
+	for _, w := range f.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Bits returns the bits set in f in ascending order.
+func (f BigFlags[T]) Bits() []T {
+	values := make([]T, 0, f.Len())
+	for i, w := range f.words {
+		for ; w != 0; w &= w - 1 { // Clear the lowest set bit each iteration
+			values = append(values, T(i*64+bits.TrailingZeros64(w)))
+		}
+	}
+	return values
+}
+
+// String returns the comma-separated symbols of the bits set in f (in ascending bit order); a
+// bit without a symbol is shown as its bit number.
+func (f BigFlags[T]) String() string {
+	enumType := reflect.TypeOf(T(0))
+	names := []string{}
+	for _, bit := range f.Bits() {
+		names = append(names, StringInt(bit, enumType))
+	}
+	return strings.Join(names, ", ")
+}
+
+// MarshalText returns the same string as String.
+func (f BigFlags[T]) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText sets f to the (case-insensitive) symbols or bit numbers in text.
+func (f *BigFlags[T]) UnmarshalText(text []byte) error {
+	parsed, err := ParseBigFlags[T](string(text), true)
+	if err == nil {
+		*f = parsed
+	}
+	return err
+}
+
+// ParseBigFlags parses a comma-separated string of T's symbols (or bit numbers) into a BigFlags;
+// a bit number that is negative or greater than MaxBigFlagsBit is an error.
+func ParseBigFlags[T Integer](s string, caseInsensitive bool) (BigFlags[T], error) {
+	f := BigFlags[T]{}
+	if strings.TrimSpace(s) == "" {
+		return f, nil // No bits set
+	}
+	var zero T
+	for _, token := range strings.Split(s, ",") {
+		token = strings.TrimSpace(token)
+		v, err := ParseInt(reflect.TypeOf(&zero), token, caseInsensitive, false)
+		if err != nil {
+			return BigFlags[T]{}, err
+		}
+		bit := v.(T)
+		if !validBigFlagsBit(bit) {
+			return BigFlags[T]{}, fmt.Errorf("couldn't parse %q into a %q: bit numbers must be from 0 to %d", token, reflect.TypeOf(zero).Name(), MaxBigFlagsBit)
+		}
+		f.Set(bit)
+	}
+	return f, nil
+}
+
+// word is an internal method that returns f's ith word (0 if f doesn't have that many words).
+func (f BigFlags[T]) word(i int) uint64 {
+	if i < len(f.words) {
+		return f.words[i]
+	}
+	return 0
+}
+
+// validBigFlagsBit is an internal function that returns true if bit is from 0 to MaxBigFlagsBit.
+func validBigFlagsBit[T Integer](bit T) bool {
+	return bit >= 0 && uint64(bit) <= MaxBigFlagsBit // bit is non-negative so uint64 preserves it
+}
+
+// bigFlagsBit is an internal function that returns the index of the word holding a bit and the
+// bit's mask within that word; it panics if the bit is negative or greater than MaxBigFlagsBit.
+func bigFlagsBit[T Integer](bit T) (word int, mask uint64) {
+	if !validBigFlagsBit(bit) {
+		panic(fmt.Sprintf("enum: bit number %d isn't from 0 to %d", bit, MaxBigFlagsBit))
+	}
+	return int(uint64(bit)) / 64, 1 << (uint64(bit) % 64)
+}
@@ -0,0 +1,91 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var ECapability = Capability(0).Read() // Helper variable used by consuming code (improves cross-package consumption)
+type Capability uint16                 // Capability's symbols are bit numbers; there are too many for a uint64
+
+// Define Capability's "symbols" and their values (bit numbers):
+func (Capability) Read() Capability   { return Capability(0) }
+func (Capability) Write() Capability  { return Capability(1) }
+func (Capability) Delete() Capability { return Capability(64) }
+func (Capability) Audit() Capability  { return Capability(130) }
+
+func ExampleBigFlags() {
+	caps := enum.NewBigFlags(ECapability.Read(), ECapability.Audit(), Capability(200))
+	printf("%s (%d bits)\n", caps, caps.Len())
+	printf("Has Audit: %t, Has Write: %t\n", caps.Has(ECapability.Audit()), caps.Has(ECapability.Write()))
+
+	caps.Clear(ECapability.Read())
+	caps.Set(ECapability.Delete())
+	b, _ := json.Marshal(caps)
+	printf("%s\n", b)
+
+	var parsed enum.BigFlags[Capability]
+	if err := json.Unmarshal([]byte(`"write, delete, 300"`), &parsed); err == nil {
+		printf("%s\n", parsed.Union(caps))
+		printf("%s\n", parsed.Intersect(caps))
+	}
+
+	// Output:
+	// Read, Audit, 200 (3 bits)
+	// Has Audit: true, Has Write: false
+	// "Delete, Audit, 200"
+	// Write, Delete, Audit, 200, 300
+	// Delete
+}
+
+var EWideBit = WideBit(0).First() // Helper variable used by consuming code (improves cross-package consumption)
+type WideBit uint64               // WideBit's symbols are bit numbers
+
+// Define WideBit's "symbols" and their values (bit numbers):
+func (WideBit) First() WideBit { return WideBit(0) }
+
+// Bit numbers that are negative or greater than MaxBigFlagsBit are rejected
+func ExampleParseBigFlags_limit() {
+	for _, s := range []string{"First, 65535", "9223372036854775808", "18446744073709551615"} {
+		f, err := enum.ParseBigFlags[WideBit](s, false)
+		printf("%q: %v (%v)\n", s, f, err)
+	}
+	var caps enum.BigFlags[Capability]
+	printf("%v\n", json.Unmarshal([]byte(`"read, 1000000000000000"`), &caps))
+	var perms enum.BigFlags[int64]
+	printf("%v\n", json.Unmarshal([]byte(`"-1"`), &perms))
+	printf("Has: %t\n", perms.Has(1000000000000000))
+	perms.Clear(1000000000000000, -1)
+
+	// Output:
+	// "First, 65535": First, 65535 (<nil>)
+	// "9223372036854775808":  (couldn't parse "9223372036854775808" into a "WideBit": bit numbers must be from 0 to 65535)
+	// "18446744073709551615":  (couldn't parse "18446744073709551615" into a "WideBit": bit numbers must be from 0 to 65535)
+	// couldn't parse "1000000000000000" into a "Capability"
+	// couldn't parse "-1" into a "int64": bit numbers must be from 0 to 65535
+	// Has: false
+}
+
+var EPermission = Permission(0).None() // Helper variable used by consuming code (improves cross-package consumption)
+type Permission int32                  // A signed bit mask (as used for C interop)
+
+// Define Permission's "symbols" and their values:
+func (Permission) None() Permission     { return Permission(0) }
+func (Permission) Query() Permission    { return Permission(0x1) }
+func (Permission) Modify() Permission   { return Permission(0x2) }
+func (Permission) Reserved() Permission { return Permission(-0x80000000) } // Bit 31
+
+func ExampleStringUintFlags_signed() {
+	p := EPermission.Query() | EPermission.Reserved() | Permission(0x100)
+	s := enum.StringUintFlags(uint64(p), reflect.TypeOf(p), 16)
+	printf("%s\n", s)
+	if v, err := enum.ParseUintFlags(reflect.TypeOf(&p), s, false); err == nil {
+		printf("%d %t\n", Permission(v), Permission(v) == p)
+	}
+
+	// Output:
+	// Query, Reserved, 0x100
+	// -2147483391 true
+}
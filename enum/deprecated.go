@@ -0,0 +1,77 @@
+package enum
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+)
+
+// Deprecation describes why a symbol is deprecated and which symbol replaces it.
+type Deprecation struct {
+	Message     string // Why the symbol is deprecated (optional)
+	Replacement string // The symbol method name that replaces the deprecated symbol (optional)
+	Substitute  bool   // If true, Parse returns the Replacement symbol's value instead of the deprecated symbol's
+}
+
+// SymbolDeprecations is implemented by an enum type that is gradually retiring some of its
+// symbols. Parse still accepts a deprecated symbol but reports its use via DeprecatedUsed.
+// For example:
+//
+//	func (Color) EnumDeprecated() map[string]enum.Deprecation {
+//	   return map[string]enum.Deprecation{"Crimson": {Message: "use Red", Replacement: "Red", Substitute: true}}
+//	}
+//
+// Each Replacement must be one of the enum type's symbol method names; this package's functions
+// panic when first used with an enum type whose Replacement isn't.
+type SymbolDeprecations interface {
+	EnumDeprecated() map[string]Deprecation
+}
+
+// checkReplacements is an internal method that panics if a deprecated symbol's Replacement
+// isn't one of the enum type's symbols.
+func (d *descriptor) checkReplacements(enumType reflect.Type) {
+	symbolNames := make([]string, 0, len(d.deprecated))
+	for symbolName := range d.deprecated {
+		symbolNames = append(symbolNames, symbolName)
+	}
+	sort.Strings(symbolNames) // Report the same symbol every time
+	for _, symbolName := range symbolNames {
+		replacement := d.deprecated[symbolName].Replacement
+		if _, ok := d.symbol(replacement); replacement != "" && !ok {
+			panic(fmt.Sprintf("enum: %s symbol %q's replacement %q is not a symbol", enumType, symbolName, replacement))
+		}
+	}
+}
+
+// DeprecatedUsed (if not nil) is invoked whenever Parse accepts a deprecated symbol. If nil,
+// Parse logs a warning via slog. Set it during initialization; it is not synchronized.
+var DeprecatedUsed func(enumType reflect.Type, symbolName string, d Deprecation)
+
+// reportDeprecated is an internal function that reports the parsing of a deprecated symbol.
+func reportDeprecated(enumType reflect.Type, symbolName string, d Deprecation) {
+	if DeprecatedUsed != nil {
+		DeprecatedUsed(enumType, symbolName, d)
+		return
+	}
+	slog.Warn("deprecated enum symbol parsed", "type", enumType.String(), "symbol", symbolName,
+		"message", d.Message, "replacement", d.Replacement)
+}
+
+// IsDeprecated returns the Deprecation for an enum type's symbol and true if the symbol is deprecated.
+func IsDeprecated(enumType reflect.Type, symbolName string) (Deprecation, bool) {
+	d, deprecated := describe(enumType).deprecated[symbolName]
+	return d, deprecated
+}
+
+// GetCurrentSymbols is like GetSymbols but skips deprecated symbols; use it to build menus,
+// help text & completion lists that should not offer deprecated symbols.
+func GetCurrentSymbols(enumType reflect.Type, esi SymbolInfo) {
+	d := describe(enumType)
+	GetSymbols(enumType, func(enumSymbolName string, enumSymbolValue interface{}) bool {
+		if _, deprecated := d.deprecated[enumSymbolName]; deprecated {
+			return false // Skip the deprecated symbol & continue enumeration
+		}
+		return esi(enumSymbolName, enumSymbolValue)
+	})
+}
@@ -0,0 +1,74 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var ELevel = Level(0).Debug() // Helper variable used by consuming code (improves cross-package consumption)
+type Level uint8              // Level is retiring its Verbose & Warn symbols
+
+// Define Level's "symbols" and their values:
+func (Level) Debug() Level   { return Level(0) }
+func (Level) Verbose() Level { return Level(0) } // Deprecated: same value as Debug
+func (Level) Info() Level    { return Level(1) }
+func (Level) Warn() Level    { return Level(2) } // Deprecated: parses as Warning
+func (Level) Warning() Level { return Level(3) }
+
+// EnumDeprecated describes Level's deprecated symbols
+func (Level) EnumDeprecated() map[string]enum.Deprecation {
+	return map[string]enum.Deprecation{
+		"Verbose": {Message: "use Debug", Replacement: "Debug"},
+		"Warn":    {Message: "use Warning", Replacement: "Warning", Substitute: true},
+	}
+}
+
+func ExampleSymbolDeprecations() {
+	enum.DeprecatedUsed = func(enumType reflect.Type, symbolName string, d enum.Deprecation) {
+		printf("%s.%s is deprecated: %s\n", enumType, symbolName, d.Message)
+	}
+	defer func() { enum.DeprecatedUsed = nil }()
+
+	for _, s := range []string{"verbose", "warn", "info"} {
+		if v, err := enum.Parse(reflect.TypeOf(&ELevel), s, true); err == nil {
+			printf("Parsed %q: %s\n", s, enum.String(v, reflect.TypeOf(ELevel))) // Prefers non-deprecated symbols
+		}
+	}
+
+	// Menus shouldn't offer deprecated symbols
+	enum.GetCurrentSymbols(reflect.TypeOf(ELevel),
+		func(enumSymbolName string, enumSymbolValue interface{}) (stop bool) {
+			printf("%-7s %d\n", enumSymbolName, enumSymbolValue)
+			return false
+		})
+
+	// Output:
+	// enum_test.Level.Verbose is deprecated: use Debug
+	// Parsed "verbose": Debug
+	// enum_test.Level.Warn is deprecated: use Warning
+	// Parsed "warn": Warning
+	// Parsed "info": Info
+	// Debug   0
+	// Info    1
+	// Warning 3
+}
+
+type Hue uint8 // Hue's deprecated Teal names a replacement that isn't a symbol
+
+// Define Hue's "symbols" and their values:
+func (Hue) Cyan() Hue { return Hue(0) }
+func (Hue) Teal() Hue { return Hue(1) }
+
+// EnumDeprecated describes Hue's deprecated symbols
+func (Hue) EnumDeprecated() map[string]enum.Deprecation {
+	return map[string]enum.Deprecation{"Teal": {Replacement: "Turquoise"}}
+}
+
+func ExampleSymbolDeprecations_badReplacement() {
+	defer func() { printf("%v\n", recover()) }()
+	enum.String(Hue(0), reflect.TypeOf(Hue(0)))
+
+	// Output:
+	// enum: enum_test.Hue symbol "Teal"'s replacement "Turquoise" is not a symbol
+}
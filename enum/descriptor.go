@@ -0,0 +1,150 @@
+package enum
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// descriptor caches the information an enum type declares about its symbols (beyond its symbol
+// methods) so that it is obtained via reflection only once per type.
+type descriptor struct {
+	symbols    []Symbol               // The enum type's symbols (from EnumSymbols or its symbol methods)
+	wireKeys   map[string]string      // Symbol method name -> stable wire key
+	aliases    map[string]string      // Old symbol name -> current symbol method name
+	aliasNames []string               // The old symbol names sorted (so matching them is deterministic)
+	deprecated map[string]Deprecation // Symbol method name -> deprecation details
+	mode       Mode                   // Whether undeclared values are accepted (Open) or rejected (Closed)
+	fields     []Field                // Multi-bit fields packed inside a bit flags value
+	ordered    []orderedSymbol        // The distinct symbol values in ordinal order
+	sorted     []orderedSymbol        // The distinct symbol values sorted by value
+	ordinals   map[interface{}]int    // Symbol value -> ordinal (index into ordered)
+	declared   bool                   // If true, ordered is in the order declared by EnumOrder (not by value)
+}
+
+// orderedSymbol is an internal type holding a symbol's name & value.
+type orderedSymbol struct {
+	name  string
+	value interface{}
+}
+
+// descriptors maps an enum's reflect.Type to its *descriptor.
+var descriptors sync.Map
+
+// describe is an internal function that returns the (cached) descriptor for an enum type;
+// enumType may be T or *T.
+func describe(enumType reflect.Type) *descriptor {
+	if enumType.Kind() == reflect.Ptr {
+		enumType = enumType.Elem() // Parse functions are passed *T; the descriptor is for T
+	}
+	if d, ok := descriptors.Load(enumType); ok {
+		return d.(*descriptor)
+	}
+	d := &descriptor{symbols: symbolsOf(enumType)}
+	zero := reflect.Zero(enumType).Interface()
+	if wk, ok := zero.(SymbolWireKeys); ok {
+		d.wireKeys = wk.EnumWireKeys()
+	}
+	if a, ok := zero.(SymbolAliases); ok {
+		d.aliases = a.EnumAliases()
+		for alias := range d.aliases {
+			d.aliasNames = append(d.aliasNames, alias)
+		}
+		sort.Strings(d.aliasNames)
+	}
+	if dep, ok := zero.(SymbolDeprecations); ok {
+		d.deprecated = dep.EnumDeprecated()
+		d.checkReplacements(enumType)
+	}
+	if m, ok := zero.(ModePolicy); ok {
+		d.mode = m.EnumMode()
+	}
+	if f, ok := zero.(FlagFields); ok {
+		d.fields = f.EnumFields()
+	}
+	d.orderSymbols(enumType)
+	actual, loaded := descriptors.LoadOrStore(enumType, d) // If another goroutine won the race, use its descriptor
+	if !loaded {
+		register(enumType) // Make the type available to LookupType
+	}
+	return actual.(*descriptor)
+}
+
+// name is an internal method that returns a symbol's wire key (if declared) or its name under n.
+func (d *descriptor) name(n Naming, symbolName string) string {
+	if key, ok := d.wireKeys[symbolName]; ok {
+		return key
+	}
+	return n.name(symbolName)
+}
+
+// matches is an internal method that returns true if s is a symbol's Go method name, its
+// wire key or its name under n.
+func (d *descriptor) matches(n Naming, symbolName string, s string, caseInsensitive bool) bool {
+	if key, ok := d.wireKeys[symbolName]; ok && Naming(nil).matches(key, s, caseInsensitive) {
+		return true
+	}
+	return n.matches(symbolName, s, caseInsensitive)
+}
+
+// orderSymbols is an internal method that sets d's sorted & ordered symbols: each distinct symbol
+// value (named by a symbol that isn't deprecated if possible) sorted by value and in the order
+// declared by the enum type's EnumOrder method (if any) followed by the remaining values sorted
+// by value.
+func (d *descriptor) orderSymbols(enumType reflect.Type) {
+	d.ordinals = map[interface{}]int{}
+	symbolValues := map[string]interface{}{} // Symbol method name -> value
+	for _, s := range d.symbols {
+		symbolValues[s.Name] = s.Value
+		if i, ok := d.ordinals[s.Value]; ok { // Another symbol has the same value
+			if _, deprecated := d.deprecated[d.ordered[i].name]; deprecated {
+				d.ordered[i].name = s.Name // Prefer the name of a symbol that isn't deprecated
+			}
+			continue
+		}
+		d.ordinals[s.Value] = len(d.ordered)
+		d.ordered = append(d.ordered, orderedSymbol{s.Name, s.Value})
+	}
+	sort.SliceStable(d.ordered, func(i, j int) bool {
+		return lessValue(reflect.ValueOf(d.ordered[i].value), reflect.ValueOf(d.ordered[j].value))
+	})
+	d.sorted = append([]orderedSymbol(nil), d.ordered...)
+	if o, ok := reflect.Zero(enumType).Interface().(SymbolOrder); ok {
+		ranks := map[interface{}]int{} // Value -> position of its first symbol in EnumOrder's list
+		for _, symbolName := range o.EnumOrder() {
+			if v, ok := symbolValues[symbolName]; ok {
+				if _, ranked := ranks[v]; !ranked {
+					ranks[v] = len(ranks)
+				}
+			}
+		}
+		rank := func(v interface{}) int {
+			if r, ok := ranks[v]; ok {
+				return r
+			}
+			return len(ranks) // Unlisted values follow the listed values
+		}
+		sort.SliceStable(d.ordered, func(i, j int) bool { return rank(d.ordered[i].value) < rank(d.ordered[j].value) })
+		d.declared = true
+	}
+	for i, s := range d.ordered {
+		d.ordinals[s.value] = i
+	}
+}
+
+// lessValue is an internal function that returns true if enum value a's underlying value
+// (integer, float or string) is less than b's.
+func lessValue(a reflect.Value, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	default:
+		return false // Keep the symbols' method order
+	}
+}
@@ -73,6 +73,64 @@ symbol method, it returns a string with the number "123". Unstrict parsing allow
 string from XML, JSON, or whatever) and being able to parse it. And then later, String converts it back to a number
 string without any loss of information.
 
+Naming Policies
+
+Symbol methods must be Go identifiers (like LightBlue) but wire formats often want names like "light_blue",
+"light-blue" or "LIGHT_BLUE". An enum type can declare a NamingPolicy by adding an EnumNaming method; String,
+StringInt and StringUintFlags then produce the transformed names and Parse, ParseInt and ParseUintFlags accept the
+transformed names (as well as the Go identifiers):
+
+ func (Color) EnumNaming() enum.Naming { return enum.SnakeCase }
+
+A Naming can also be used for a single call, overriding the type's policy:
+
+ s := enum.KebabCase.String(c, reflect.TypeOf(c))
+
+Stable Wire Keys and Renamed Symbols
+
+Since String returns a symbol's method name, renaming a symbol method breaks any strings that were persisted. An enum
+type can declare a stable wire key for each symbol (used by String & Parse instead of the method name) and the old
+names of renamed symbols (still accepted by Parse):
+
+ func (Color) EnumWireKeys() map[string]string { return map[string]string{"Red": "red", "Blue": "blue"} }
+ func (Color) EnumAliases() map[string]string  { return map[string]string{"Crimson": "Red"} }
+
+Set the AliasUsed hook to find out when Parse accepts an old name.
+
+Deprecated Symbols
+
+Symbols can be retired gradually by declaring them deprecated (with a message and a replacement symbol). Parse still
+accepts a deprecated symbol but reports its use via the DeprecatedUsed hook (or logs a warning via slog) and, if
+Substitute is true, returns the replacement symbol's value:
+
+ func (Color) EnumDeprecated() map[string]enum.Deprecation {
+    return map[string]enum.Deprecation{"Crimson": {Message: "use Red", Replacement: "Red", Substitute: true}}
+ }
+
+Use GetCurrentSymbols instead of GetSymbols to build menus that hide deprecated symbols.
+
+Localized Display Names
+
+A Catalog holds localized display names keyed by enum type (named as LookupType accepts, like
+"example.com/app/storage.Tier" or "storage.Tier"), symbol and locale (like "fr" or "fr-CA"); catalogs can be loaded from
+JSON or gettext PO files. Display returns a value's display name (falling back to parent locales and then to String's
+symbol), DisplayFlags joins a flags value's display names with the locale's separator and ParseDisplay/ParseDisplayFlags
+convert display names (using Unicode case folding) back to values:
+
+ enum.DefaultCatalog.LoadJSON(file)
+ s := enum.Display(EColor.Red(), "fr") // "Rouge"
+
+Open and Closed Enumerated Types
+
+An enum type can declare how it treats values & symbols that it doesn't declare by adding an EnumMode method. A
+Closed type always parses strictly and MarshalText rejects undeclared values. An Open type preserves undeclared values
+so they round-trip; for a string-based Open type, Parse returns an unknown symbol verbatim. For integer-based types,
+OpenValue[T] preserves unknown symbols verbatim and IsKnown reports whether a value matches a declared symbol:
+
+ func (Color) EnumMode() enum.Mode                 { return enum.EMode.Closed() }
+ func (c Color) MarshalText() ([]byte, error)      { return enum.MarshalText(c) }
+ func (c *Color) UnmarshalText(text []byte) error { return enum.UnmarshalText(c, text) }
+
 Getting all of an Enumerated Types's Symbols and Values
 
 This enum package offers a GetSymbols function that invokes your callback method once for each of your
@@ -90,14 +148,90 @@ symbol's string along with its numeric value.
        return false
     })
 
+By default, every method that takes no arguments and returns the enumerated type is a symbol, so a helper like
+func (a Access) Not() Access would become one. A type can instead declare exactly which symbols exist with an
+EnumSymbols method; all of this package's functions then use only those symbols:
+
+ func (Access) EnumSymbols() []enum.Symbol {
+    return []enum.Symbol{{"None", EAccess.None()}, {"Read", EAccess.Read()}, ...}
+ }
+
+An enumerated type whose symbols are constants (like the ColorIdiomaticEnum type declared with iota) gets all of this
+package's functionality once its symbols are registered with Register (or RegisterSymbols):
+
+ func init() { enum.Register(map[string]Color{"None": ColorNone, "Red": ColorRed, ...}) }
+
+Similarly, an enumerated type whose symbols are the fields of a struct value (like EColorStruct) is registered with
+RegisterStruct, which keeps a frozen copy of the fields; ValidateStruct reports fields that were changed afterwards:
+
+ func init() { enum.RegisterStruct(EColorStruct) }
+ err := enum.ValidateStruct(EColorStruct) // An error if EColorStruct.Red = 123 was executed
+
+All and Values return iterators (for use with for-range or the slices and maps packages) over an enumerated type's
+symbols in GetSymbols' order; SortedAll and SortedValues produce each distinct value once, sorted by value, and
+OrdinalAll and OrdinalValues produce each distinct value once in ordinal order (see EnumOrder below). Bits iterates
+over the bits set in a bit flags value:
+
+ for name, color := range enum.All[Color]() { ... }
+ colors := slices.Collect(enum.SortedValues[Color]())
+
+EnumSet[T] is a compact set of an ordinary enumerated type's values (use it instead of a map[Color]bool). It is a
+bitset indexed by each symbol's ordinal (its position in the order declared by EnumOrder or else sorted by value),
+iterates in that order and encodes as a list of symbols ("Red, Blue" as text or ["Red","Blue"] as JSON):
+
+ colors := enum.NewEnumSet(EColor.Blue(), EColor.Red())
+ s := colors.String() // "Red, Blue"
+ colors, err := enum.ParseEnumSet[Color]("red, green", true)
+
+EnumMap[T, V] is a lookup table keyed by symbol (use it instead of a map[Color]V) whose values are stored in a slice
+indexed by each symbol's position. NewEnumMap fails if any of T's symbols has no entry, so adding a symbol can't leave a
+table incomplete; an EnumMap encodes to JSON as an object keyed by symbol (decoding accepts any such object, so use
+Missing to check that a decoded EnumMap is complete):
+
+ hex, err := enum.NewEnumMap(map[Color]string{EColor.None(): "", EColor.Red(): "#f00", ...})
+
+Index, FromIndex, Count, First, Last, Next, Prev and Cycle navigate an enumerated type's symbols in ordinal order
+(declared by EnumOrder or else sorted by value), which is useful for UI steppers and state progressions. Next and Prev
+either clamp or wrap at the ends; a value with no symbol steps to the nearest symbol. The Ordinals[T] handle offers the
+same operations without type assertions:
+
+ next := enum.Ordinals[Color]{}.Next(EColor.Green(), enum.EBound.Wrap()) // EColor.Blue()
+
+Compare, Less, AtLeast, AtMost, Max, Min and Sort order an enumerated type's values (like log levels or priorities)
+by value. When the declared order differs from the numeric order (for example, because a symbol was added later), an
+EnumOrder method lists the symbols from lowest to highest; this order is also used by Index, Next, EnumSet, etc.:
+
+ func (Severity) EnumOrder() []string { return []string{"Debug", "Info", "Warn", "Error"} }
+ if enum.AtLeast(s, ESeverity.Warn()) { ... }
+
+Enum types are added to a registry the first time this package uses them (or explicitly via RegisterType) so code
+that receives type names as strings (like a plugin system or config loader) can find them. LookupType accepts names
+like "storage.Tier" or "example.com/app/storage.Tier", RegisteredTypes lists the registered types and ParseQualified
+parses a qualified symbol:
+
+ v, err := enum.ParseQualified("storage.Tier.Hot", true) // v is storage.Tier(0)
+
+Dynamic Enumerated Types
+
+Enumerated types whose symbols are only known at runtime (like tenant-configurable lists loaded from a JSON file)
+can't be defined with symbol methods. A DynamicEnum is built from name/value pairs (via NewDynamicEnum or by
+unmarshaling its JSON definition) and offers String, StringInt, Parse, ParseInt, StringFlags, ParseFlags and
+ValidateFlags methods that behave like this package's functions. RegisterDynamic adds it to the registry used by
+ParseQualified and a Catalog's display names for it are keyed by its name:
+
+ plan := &enum.DynamicEnum{}
+ err := json.Unmarshal([]byte(`{"name": "tenant.Plan", "symbols": [{"name": "Free", "value": 0}, ...]}`), plan)
+ s := plan.String(0) // "Free"
+
 Working with Bit Flag Enumerated Types
 
-You can also define enumerated types that consist of bit flags (symbols) that you can bitwise-OR together. Note that
-the enumerated type underlying type MUST be an unsigned integer (like uint32). Here is an example of an enumerated
-type that defines a set of potential access conditions:
+You can also define enumerated types that consist of bit flags (symbols) that you can bitwise-OR together. The
+enumerated type's underlying type should be an unsigned integer (like uint32) although signed integers (like int32 bit
+masks used for C interop) are also supported. Here is an example of an enumerated type that defines a set of potential
+access conditions:
 
  var EAccess = Access(0).None() // Helper variable used by consuming code (improves cross-package consumption)
- type Access uint32             // I want Access enum variables to flags (MUST be an unsigned integer)
+ type Access uint32             // I want Access enum variables to flags (should be an unsigned integer)
 
  // Define Access' "symbols" and their values (Note that each symbol is represented by a bit):
  func (Access) None() Access           { return Access(0x00) }
@@ -130,5 +264,48 @@ Here is code showing how to use String and Parse with this enumerated type:
  } else {
     printf("Error: %v\n", err)
  }
+
+The Has, HasAny, Set, Clear, Toggle, Union, Intersect, Difference, Complement, Mask, PopCount, Split and SplitBits
+functions operate on any bit flags enumerated type and return values of that type; Split returns a value's symbols (as
+StringUintFlags does) & its leftover undeclared bits while SplitBits returns each of its bits:
+
+ if enum.Has(a, EAccess.Write()) { ... }
+
+DiffFlags describes how a bit flags value changed, which is useful for audit logs (a FlagDiff can be logged via slog):
+
+ d := enum.DiffFlags(oldAccess, newAccess)
+ s := d.Format("granted", "revoked") // "granted Write; revoked Execute"
+
+FormatUintFlags and ParseFormattedUintFlags accept a FlagFormat specifying the separator (like "|"), the base & prefix
+of bits without a symbol, the name for zero and whether composite symbols (like ReadWrite = Read|Write) are preferred
+or expanded into single-bit symbols. A string formatted with a FlagFormat parses back with the same FlagFormat:
+
+ f := enum.FlagFormat{Separator: "|", Style: enum.EFlagStyle.Fewest()}
+ s := enum.FormatUintFlags(uint64(a), reflect.TypeOf(a), f) // "Read|Write"
+
+Setting FlagFormat's Expressions field lets users write flag expressions like "all, -Execute" or "Blob*, !BlobDeleted"
+(see ParseFormattedUintFlags); FormatUintFlags then produces the shortest equivalent expression.
+
+A bit flags enumerated type can also pack multi-bit fields whose values are symbols of another enumerated type. Declare
+them with an EnumFields method; formatting then renders each field as "Name=Symbol" (like "Compressed, Priority=High")
+and parsing accepts the same:
+
+ func (Header) EnumFields() []enum.Field {
+    return []enum.Field{{Name: "Priority", Mask: 0x70, Type: reflect.TypeOf(EPriority)}}
+ }
+
+A bit flags enumerated type can also be rendered as a compact positional string (like "r-x" as shown by ls -l) by
+declaring each position's symbol & character with an EnumFlagChars method; StringPositional and ParsePositional
+convert between values and these strings:
+
+ func (Access) EnumFlagChars() []enum.FlagChar {
+    return []enum.FlagChar{{"Read", 'r'}, {"Write", 'w'}, {"Execute", 'x'}}
+ }
+
+For enumerated types with more than 64 flags, use BigFlags[T] (backed by a []uint64). T's symbols are bit numbers
+(0 through MaxBigFlagsBit) instead of bit masks:
+
+ caps := enum.NewBigFlags(ECapability.Read(), ECapability.Audit())
+ s := caps.String() // "Read, Audit"
 */
 package enum
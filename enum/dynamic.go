@@ -0,0 +1,221 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DynamicSymbol is one of a DynamicEnum's symbols.
+type DynamicSymbol struct {
+	Name  string `json:"name"`
+	Value int64  `json:"value"`
+}
+
+// DynamicEnum is an enum type defined at runtime from data (like a tenant-configurable list of
+// symbols loaded from a JSON file) instead of by symbol methods. Its values are int64s (uint64s
+// for bit flags) and its methods behave like this package's functions for the equivalent symbol
+// method type. A DynamicEnum is safe for concurrent use but must not be unmarshaled into once in
+// use. Its JSON representation is:
+//
+//	{"name": "tenant.Plan", "mode": "Closed", "symbols": [{"name": "Free", "value": 0}, ...]}
+type DynamicEnum struct {
+	name    string
+	mode    Mode
+	symbols []DynamicSymbol
+}
+
+// NewDynamicEnum returns a DynamicEnum named name (like "tenant.Plan") with the specified mode &
+// symbols; it returns an error if a symbol's name is empty or used by another symbol (ignoring
+// case). Several symbols may have the same value.
+func NewDynamicEnum(name string, mode Mode, symbols ...DynamicSymbol) (*DynamicEnum, error) {
+	if name == "" {
+		return nil, errors.New("a dynamic enum must have a name")
+	}
+	seen := map[string]bool{}
+	for _, s := range symbols {
+		switch folded := strings.ToLower(s.Name); {
+		case strings.TrimSpace(s.Name) == "":
+			return nil, fmt.Errorf("%s has a symbol with no name", name)
+		case seen[folded]:
+			return nil, fmt.Errorf("%s has more than 1 symbol named %q", name, s.Name)
+		default:
+			seen[folded] = true
+		}
+	}
+	return &DynamicEnum{name: name, mode: mode, symbols: append([]DynamicSymbol(nil), symbols...)}, nil
+}
+
+// Name returns d's name.
+func (d *DynamicEnum) Name() string { return d.name }
+
+// Mode returns how d treats values & symbols it doesn't declare.
+func (d *DynamicEnum) Mode() Mode { return d.mode }
+
+// Symbols returns d's symbols in the order they were defined.
+func (d *DynamicEnum) Symbols() []DynamicSymbol {
+	return append([]DynamicSymbol(nil), d.symbols...)
+}
+
+// IsKnown returns true if v matches one of d's symbols.
+func (d *DynamicEnum) IsKnown(v int64) bool {
+	_, found := d.symbol(v)
+	return found
+}
+
+// symbol is an internal method that returns the name of v's (first) symbol.
+func (d *DynamicEnum) symbol(v int64) (string, bool) {
+	for _, s := range d.symbols {
+		if s.Value == v {
+			return s.Name, true
+		}
+	}
+	return "", false
+}
+
+// String returns the symbol for v like String does. If v has no symbol, "" is returned.
+func (d *DynamicEnum) String(v int64) string {
+	name, _ := d.symbol(v)
+	return name
+}
+
+// StringInt returns the symbol for v like StringInt does. If v has no symbol, a string
+// containing the integer value (in decimal) is returned.
+func (d *DynamicEnum) StringInt(v int64) string {
+	if name, found := d.symbol(v); found {
+		return name
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+// Parse converts one of d's symbols to its value like Parse does.
+func (d *DynamicEnum) Parse(s string, caseInsensitive bool) (int64, error) {
+	for _, symbol := range d.symbols {
+		if Naming(nil).matches(symbol.Name, s, caseInsensitive) {
+			return symbol.Value, nil
+		}
+	}
+	return 0, fmt.Errorf("couldn't parse %q into a %q", s, d.name)
+}
+
+// ParseInt converts one of d's symbols to its value like ParseInt does. If strict is false, s
+// may also be an integer string. Parsing is always strict if d is Closed.
+func (d *DynamicEnum) ParseInt(s string, caseInsensitive bool, strict bool) (int64, error) {
+	v, err := d.Parse(s, caseInsensitive)
+	if err == nil || strict || d.mode == EMode.Closed() {
+		return v, err
+	}
+	if i, parseErr := strconv.ParseInt(s, 0, 64); parseErr == nil {
+		return i, nil
+	}
+	return 0, err
+}
+
+// flagSymbols is an internal method that returns d's symbols & their bits.
+func (d *DynamicEnum) flagSymbols() []flagSymbol {
+	symbols := make([]flagSymbol, len(d.symbols))
+	for i, s := range d.symbols {
+		symbols[i] = flagSymbol{s.Name, uint64(s.Value)}
+	}
+	return symbols
+}
+
+// StringFlags formats intValue's bit flags like FormatUintFlags does. f's Expressions field is
+// ignored; a DynamicEnum has no flag expressions or multi-bit fields.
+func (d *DynamicEnum) StringFlags(intValue uint64, f FlagFormat) string {
+	return f.withDefaults().formatSymbols(intValue, d.flagSymbols(), nil, func(symbolName string) string { return symbolName })
+}
+
+// ParseFlags parses a string of d's symbols formatted as specified by f like
+// ParseFormattedUintFlags does (ignoring f's Expressions field).
+func (d *DynamicEnum) ParseFlags(s string, caseInsensitive bool, f FlagFormat) (uint64, error) {
+	f = f.withDefaults()
+	if strings.TrimSpace(s) == "" {
+		return 0, nil // A value with no symbols
+	}
+	declared := uint64(0)
+	for _, symbol := range d.flagSymbols() {
+		declared |= symbol.value
+	}
+	val := uint64(0)
+	for _, token := range f.split(s) {
+		if f.ZeroName != "" && Naming(nil).matches(f.ZeroName, token, caseInsensitive) {
+			continue // The zero name adds no bits
+		}
+		if v, err := d.Parse(token, caseInsensitive); err == nil {
+			val |= uint64(v)
+			continue
+		}
+		bits, err := f.parseUndeclared(token, d.name, 64, f.Strict || d.mode == EMode.Closed(), declared)
+		if err != nil {
+			return 0, err
+		}
+		val |= bits
+	}
+	return val, nil
+}
+
+// ValidateFlags returns an error if any of d's composite symbols partially overlap like
+// ValidateFlags does.
+func (d *DynamicEnum) ValidateFlags() error {
+	return errors.Join(overlapErrors(d.name, d.flagSymbols())...)
+}
+
+// Display returns v's display name in a locale from c (whose entries are keyed by d's name). If
+// c has none, v's symbol is returned; if v has no symbol, its integer value is returned.
+func (d *DynamicEnum) Display(c *Catalog, v int64, locale string) string {
+	name, found := d.symbol(v)
+	if !found {
+		return strconv.FormatInt(v, 10)
+	}
+	if displayName, ok := c.lookup(name, locale, d.name); ok {
+		return displayName
+	}
+	return name
+}
+
+// dynamicJSON is the JSON representation of a DynamicEnum.
+type dynamicJSON struct {
+	Name    string          `json:"name"`
+	Mode    string          `json:"mode,omitempty"` // A Mode symbol; the default is "Default"
+	Symbols []DynamicSymbol `json:"symbols"`
+}
+
+// MarshalJSON returns d's definition in the JSON format shown by DynamicEnum.
+func (d *DynamicEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dynamicJSON{Name: d.name, Mode: d.mode.String(), Symbols: d.symbols})
+}
+
+// UnmarshalJSON sets d to the definition in data (in the JSON format shown by DynamicEnum); it
+// returns the same errors as NewDynamicEnum.
+func (d *DynamicEnum) UnmarshalJSON(data []byte) error {
+	dj := dynamicJSON{}
+	if err := json.Unmarshal(data, &dj); err != nil {
+		return err
+	}
+	mode := EMode.Default()
+	if dj.Mode != "" {
+		m, err := ParseInt(reflect.TypeOf(&mode), dj.Mode, true, true)
+		if err != nil {
+			return err
+		}
+		mode = m.(Mode)
+	}
+	parsed, err := NewDynamicEnum(dj.Name, mode, dj.Symbols...)
+	if err == nil {
+		*d = *parsed
+	}
+	return err
+}
+
+// DynamicValue is a value of a DynamicEnum (as returned by ParseQualified).
+type DynamicValue struct {
+	Enum  *DynamicEnum
+	Value int64
+}
+
+// String returns v's symbol (or its integer value if it has no symbol).
+func (v DynamicValue) String() string { return v.Enum.StringInt(v.Value) }
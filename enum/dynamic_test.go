@@ -0,0 +1,41 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleDynamicEnum() {
+	definition := `{"name": "tenant.Feature", "mode": "Closed", "symbols": [
+		{"name": "None", "value": 0}, {"name": "Export", "value": 1}, {"name": "Audit", "value": 2},
+		{"name": "SSO", "value": 4}, {"name": "Enterprise", "value": 7}]}`
+	feature := &enum.DynamicEnum{}
+	if err := json.NewDecoder(strings.NewReader(definition)).Decode(feature); err != nil {
+		printf("%v\n", err)
+		return
+	}
+	printf("%s %s\n", feature.String(2), feature.StringInt(9))
+	v, err := feature.Parse("sso", true)
+	printf("%d %v\n", v, err)
+
+	f := enum.FlagFormat{Separator: "|", Style: enum.EFlagStyle.Fewest()}
+	printf("%s\n", feature.StringFlags(7|8, f))
+	bits, err := feature.ParseFlags("export|sso", true, f)
+	printf("%d %v\n", bits, err)
+	_, err = feature.ParseFlags("export|0x8", true, f)
+	printf("%v\n", err)
+
+	_ = enum.RegisterDynamic(feature)
+	q, err := enum.ParseQualified("tenant.Feature.Audit", false)
+	printf("%v %v\n", q, err)
+
+	// Output:
+	// Audit 9
+	// 4 <nil>
+	// Enterprise|0x8
+	// 5 <nil>
+	// couldn't parse "0x8" into a "tenant.Feature": bits 0x8 have no symbol
+	// Audit <nil>
+}
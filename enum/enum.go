@@ -9,7 +9,8 @@ import (
 
 // SymbolInfo defines a callback function that is invoked once per an enum type's symbol.
 // The callback is passed the enum's symbol and its value.
-// Return false to continue enumerating enum symbols/values or false to prematurely stop enumeration.
+// Return false to continue enumerating enum symbols/values or true to prematurely stop enumeration.
+// Range over All or Values instead to avoid a callback.
 type SymbolInfo func(enumSymbolName string, enumSymbolValue interface{}) (stop bool)
 
 // isValidEnumSymbolMethod is an internal function that returns true if an enum type's
@@ -20,33 +21,35 @@ func isValidEnumSymbolMethod(enumType reflect.Type, m reflect.Method) bool {
 }
 
 // GetSymbols invokes the SymbolInfo callback method once for each symbol defined on the enum type.
+// The symbols are the enum type's symbol methods or, if it has an EnumSymbols method, the symbols
+// it returns.
 func GetSymbols(enumType reflect.Type, esi SymbolInfo) {
-	// Pass 1 argument that is a zero-value of t
-	args := [1]reflect.Value{reflect.Zero(enumType)}
-
-	// Call enum methods looking for one that returns the same value we have
-	for m := 0; m < enumType.NumMethod(); m++ {
-		method := enumType.Method(m)
-		if !isValidEnumSymbolMethod(enumType, method) {
-			continue
-		}
-		// Call the enum method, convert the result to the enumType interface
-		value := method.Func.Call(args[:])[0].Convert(enumType).Interface()
+	for _, s := range describe(enumType).symbols {
 		// Pass the symbol name & value to the callback; stop enumeration if the callback returns true
-		if esi(method.Name, value) {
+		if esi(s.Name, s.Value) {
 			return
 		}
 	}
 }
 
 // String returns the symbol for a enum type's value. If the value has no symbol, "" is returned.
+// The symbol is transformed by the enum type's NamingPolicy (if any). If several symbols have
+// the value, a symbol that is not deprecated is preferred.
 func String(enumValue interface{}, enumType reflect.Type) string {
+	return namingOf(enumType).String(enumValue, enumType)
+}
+
+// String returns the symbol (transformed by n) for a enum type's value. If the value has no
+// symbol, "" is returned.
+func (n Naming) String(enumValue interface{}, enumType reflect.Type) string {
 	symbolResult := ""
+	d := describe(enumType)
 	// Get symbols; if symbol's value matches enumValue, return symbol's name & stop enumeration
 	GetSymbols(enumType, func(symbol string, value interface{}) bool {
 		if value == enumValue {
-			symbolResult = symbol
-			return true
+			symbolResult = d.name(n, symbol)
+			_, deprecated := d.deprecated[symbol]
+			return !deprecated // Keep looking for a current symbol with the same value
 		}
 		return false
 	})
@@ -56,9 +59,15 @@ func String(enumValue interface{}, enumType reflect.Type) string {
 // StringInt returns the symbol for a enum type's value. If the value has no symbol,
 // a string containing the integer value (in decimal) is returned.
 func StringInt(intValue interface{}, enumType reflect.Type) string {
+	return namingOf(enumType).StringInt(intValue, enumType)
+}
+
+// StringInt returns the symbol (transformed by n) for a enum type's value. If the value has no
+// symbol, a string containing the integer value (in decimal) is returned.
+func (n Naming) StringInt(intValue interface{}, enumType reflect.Type) string {
 	// Calls enumType’s methods that return an enumType
 	// If returned value matches intValue, return method’s name; else return intValue as string
-	if symbolName := String(intValue, enumType); symbolName != "" {
+	if symbolName := n.String(intValue, enumType); symbolName != "" {
 		return symbolName // Returns matching symbol (if found)
 	}
 	return fmt.Sprintf("%d", intValue) // No match, return the number as a string
@@ -66,45 +75,30 @@ func StringInt(intValue interface{}, enumType reflect.Type) string {
 
 // StringUintFlags considers intValue as a bit of bit flags OR'd together and returns the
 // comma-separated symbols whose bits are present. If the value has bits set which do not
-// correspond to any symbol, then the remaining integer value (in intBase) is concatenated
-// to the string.
+// correspond to any symbol, then the remaining integer value (in intBase with a matching
+// 0b/0o/0x prefix) is concatenated to the string. The enum type's underlying type may be any
+// integer kind; a signed value's bits are its two's complement representation. Use
+// FormatUintFlags for more control.
 func StringUintFlags(intValue uint64, enumType reflect.Type, intBase int) string {
-	// Call flag's methods that return a flag
-	// if flag == 0, return symbol/method that returns 0
-	// else skip any method/symbol that returns 0; concatenate to string any method whose return value & f == method's return value
-	// return string
-	bitsFound := uint64(0)
-	symbolNames := strings.Builder{}
-	GetSymbols(enumType, func(symbolName string, symbolValue interface{}) bool {
-		symVal := reflect.ValueOf(symbolValue).Uint()
-		if intValue == 0 && symVal == 0 {
-			symbolNames.WriteString(symbolName) // We found a match, return the method's name (the enum's symbol)
-			return true                         // Stop
-		}
-		if symVal != 0 && (intValue&symVal == symVal) {
-			bitsFound |= symVal
-			if symbolNames.Len() > 0 {
-				symbolNames.WriteString(", ")
-			}
-			symbolNames.WriteString(symbolName)
-		}
-		return false // Continue symbol enumeration
-	})
-	if bitsFound != intValue {
-		// Some bits in the original value were not accounted for, append the remaining decimal value
-		if symbolNames.Len() > 0 {
-			symbolNames.WriteString(", ")
-		}
-		symbolNames.WriteString("0x")	// Prefix base-16 integer with "0x"
-		symbolNames.WriteString(strconv.FormatUint(intValue^bitsFound, intBase))
-	}
-	return symbolNames.String() // Returns matching symbol (if found)
+	return namingOf(enumType).StringUintFlags(intValue, enumType, intBase)
 }
 
-// Parse converts an enum type's symbol to its corresponding value.
+// StringUintFlags is like the StringUintFlags function but transforms each symbol using n.
+func (n Naming) StringUintFlags(intValue uint64, enumType reflect.Type, intBase int) string {
+	d := describe(enumType)
+	return FlagFormat{Base: intBase}.format(intValue, enumType, func(symbolName string) string { return d.name(n, symbolName) })
+}
+
+// ParseInt converts an enum type's symbol to its corresponding value. If strict is false, s
+// may also be an integer string. Parsing is always strict for a Closed enum type.
 func ParseInt(enumTypePtr reflect.Type, s string, caseInsensitive bool, strict bool) (enumVal interface{}, err error) {
-	enumVal, err = Parse(enumTypePtr, s, caseInsensitive)
-	if err == nil || strict {
+	return namingOf(enumTypePtr).ParseInt(enumTypePtr, s, caseInsensitive, strict)
+}
+
+// ParseInt is like the ParseInt function but also accepts symbols transformed by n.
+func (n Naming) ParseInt(enumTypePtr reflect.Type, s string, caseInsensitive bool, strict bool) (enumVal interface{}, err error) {
+	enumVal, err = n.Parse(enumTypePtr, s, caseInsensitive)
+	if err == nil || strict || describe(enumTypePtr).mode == EMode.Closed() {
 		return // If no error or strict parsing, return Parse's results
 	}
 
@@ -128,8 +122,16 @@ func ParseInt(enumTypePtr reflect.Type, s string, caseInsensitive bool, strict b
 	return
 }
 
-// Parse converts an enum type's symbol to its corresponding value.
+// Parse converts an enum type's symbol to its corresponding value. The symbol may be the
+// symbol's method name or its name under the enum type's NamingPolicy (if any). For an Open
+// enum type whose underlying type is a string, an unknown symbol is returned verbatim.
 func Parse(enumTypePtr reflect.Type, s string, caseInsensitive bool) (interface{}, error) {
+	return namingOf(enumTypePtr).Parse(enumTypePtr, s, caseInsensitive)
+}
+
+// Parse converts an enum type's symbol (its method name or its name under n) to its
+// corresponding value.
+func (n Naming) Parse(enumTypePtr reflect.Type, s string, caseInsensitive bool) (interface{}, error) {
 	// Finds enumType's method named s (optionally case-insensitive).
 	// If found, calls it and returns its value; else returns error
 	// sets c to its value & returns
@@ -137,51 +139,57 @@ func Parse(enumTypePtr reflect.Type, s string, caseInsensitive bool) (interface{
 	// Parses s as integer; if OK, set c to int & returns; else returns error
 
 	enumType := enumTypePtr.Elem() // Convert from *T to T
-	// Look for a method name that matches the string we're trying to parse
-	if method, found := findMethod(enumType, s, caseInsensitive); found {
-		// Pass 1 argument that is a zero-value of t.
-		args := [1]reflect.Value{reflect.Zero(enumType)}
-
-		// Call the enum type's method passing in the arg receiver; the returned t is converted to an EnumInt32
+	// Look for a symbol name that matches the string we're trying to parse
+	if symbol, found := findSymbol(enumType, s, caseInsensitive, n); found {
+		if dep, deprecated := describe(enumType).deprecated[symbol.Name]; deprecated {
+			reportDeprecated(enumType, symbol.Name, dep)
+			if replacement, found := describe(enumType).symbol(dep.Replacement); dep.Substitute && found {
+				symbol = replacement // Return the replacement symbol's value instead
+			}
+		}
 		// The caller must convert this to their exact type
-		return method.Func.Call(args[:])[0].Convert(enumType).Interface(), nil
+		return symbol.Value, nil
+	}
+	if enumType.Kind() == reflect.String && describe(enumType).mode == EMode.Open() {
+		return reflect.ValueOf(s).Convert(enumType).Interface(), nil // Preserve the unknown symbol
 	}
 	return nil, fmt.Errorf("couldn't parse %q into a %q", s, enumType.Name())
 }
 
-// findMethod is an internal function that looks up an enum type's method (symbol) by name.
-func findMethod(enumType reflect.Type, methodName string, caseInsensitive bool) (reflect.Method, bool) {
-	if !caseInsensitive {
-		return enumType.MethodByName(methodName) // Look up the method by exact name and case
+// findSymbol is an internal function that looks up an enum type's symbol by name, by its wire
+// key, by its name under n or by one of its old names (aliases).
+func findSymbol(enumType reflect.Type, symbolName string, caseInsensitive bool, n Naming) (Symbol, bool) {
+	d := describe(enumType)
+	for _, symbol := range d.symbols { // Iterate through all the symbols matching their names
+		if d.matches(n, symbol.Name, symbolName, caseInsensitive) {
+			return symbol, true
+		}
 	}
-	methodName = strings.ToLower(methodName)    // lowercase the passed method name
-	for m := 0; m < enumType.NumMethod(); m++ { // Iterate through all the methods matching their lowercase equivalents
-		method := enumType.Method(m)
-		if strings.ToLower(method.Name) == methodName {
-			return method, true
+	if alias, current, found := d.resolveAlias(symbolName, caseInsensitive); found {
+		if symbol, found := d.symbol(current); found {
+			if AliasUsed != nil {
+				AliasUsed(enumType, alias, current) // Let the app know that persisted data uses an old name
+			}
+			return symbol, true
 		}
 	}
-	return reflect.Method{}, false
+	return Symbol{}, false
 }
 
 // ParseUintFlags parses a comma-separated string of symbols OR-ing each symbol's value. The
-// final value is returned.
+// final value is returned. Integer strings are accepted unless the enum type is Closed; use
+// ParseFormattedUintFlags with a Strict FlagFormat to reject them for other enum types. An
+// empty string is an error (unlike with ParseFormattedUintFlags).
 func ParseUintFlags(enumTypePtr reflect.Type, s string, caseInsensitive bool) (uint64, error) {
-	val := uint64(0)
-	for _, f := range strings.Split(s, ",") {
-		f = strings.TrimSpace(f)
-		v, err := Parse(enumTypePtr, f, caseInsensitive)
-		if err == nil {
-			val |= reflect.ValueOf(v).Uint() // Symbol found, OR its value
-		} else {
-			// strict is off: Try to parse f as a string of digits into a uint64 & return its value
-			i, err := strconv.ParseUint(f, 0, int(enumTypePtr.Elem().Size())*8)
-			if err == nil {
-				val |= i // Successful parse, OR its value
-			} else {
-				return 0, fmt.Errorf("couldn't parse %q into a %q", f, enumTypePtr.Elem().Name())
-			}
-		}
+	return namingOf(enumTypePtr).ParseUintFlags(enumTypePtr, s, caseInsensitive)
+}
+
+// ParseUintFlags is like the ParseUintFlags function but also accepts symbols transformed by n.
+func (n Naming) ParseUintFlags(enumTypePtr reflect.Type, s string, caseInsensitive bool) (uint64, error) {
+	if strings.TrimSpace(s) == "" {
+		return 0, fmt.Errorf("couldn't parse %q into a %q", "", enumTypePtr.Elem().Name())
 	}
-	return val, nil
+	return FlagFormat{}.parse(enumTypePtr, s, caseInsensitive, func(symbol string) (interface{}, error) {
+		return n.Parse(enumTypePtr, symbol, caseInsensitive)
+	})
 }
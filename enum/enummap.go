@@ -0,0 +1,147 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// EnumMap is a lookup table keyed by an enum type's symbols (like a handler per Color or a
+// price per Tier); use it instead of a map[Color]V. Its values are stored in a slice indexed by
+// each symbol's ordinal so only values with a symbol can be keys. Its zero value is empty; use
+// NewEnumMap to ensure that every symbol has an entry.
+type EnumMap[T comparable, V any] struct {
+	values  []V           // values[n] is the value for the symbol with ordinal n
+	present BigFlags[int] // Bit n is set if the symbol with ordinal n has an entry
+}
+
+// NewEnumMap returns an EnumMap holding entries; it returns an error if any of T's symbols has
+// no entry or if a key has no symbol.
+func NewEnumMap[T comparable, V any](entries map[T]V) (EnumMap[T, V], error) {
+	m := EnumMap[T, V]{}
+	d := describe(reflect.TypeOf(*new(T)))
+	for k, v := range entries {
+		if _, ok := d.ordinals[k]; !ok {
+			return EnumMap[T, V]{}, fmt.Errorf("%v is not a symbol of %s", underlyingString(reflect.ValueOf(k)), reflect.TypeOf(k))
+		}
+		m.Set(k, v)
+	}
+	if missing := m.Missing(); len(missing) > 0 {
+		return EnumMap[T, V]{}, fmt.Errorf("EnumMap[%s] has no entry for %s", reflect.TypeOf(*new(T)).Name(), NewEnumSet(missing...))
+	}
+	return m, nil
+}
+
+// Get returns the value for k and true if k has an entry.
+func (m EnumMap[T, V]) Get(k T) (V, bool) {
+	ordinal, ok := describe(reflect.TypeOf(k)).ordinals[k]
+	if !ok || !m.present.Has(ordinal) {
+		var zero V
+		return zero, false
+	}
+	return m.values[ordinal], true
+}
+
+// Set sets the value for k; it panics if k has no symbol.
+func (m *EnumMap[T, V]) Set(k T, v V) {
+	ordinal := ordinalOf(k)
+	if m.values == nil {
+		m.values = make([]V, len(describe(reflect.TypeOf(k)).ordered))
+	}
+	m.values[ordinal] = v
+	m.present.Set(ordinal)
+}
+
+// Delete removes k's entry.
+func (m *EnumMap[T, V]) Delete(k T) {
+	if ordinal, ok := describe(reflect.TypeOf(k)).ordinals[k]; ok && m.present.Has(ordinal) {
+		var zero V
+		m.values[ordinal] = zero // Don't keep the value alive
+		m.present.Clear(ordinal)
+	}
+}
+
+// Len returns the number of entries in m.
+func (m EnumMap[T, V]) Len() int {
+	return m.present.Len()
+}
+
+// Keys returns the symbols that have an entry (in symbol order).
+func (m EnumMap[T, V]) Keys() []T {
+	return EnumSet[T]{ordinals: m.present}.Values()
+}
+
+// Missing returns T's symbols that have no entry (in symbol order).
+func (m EnumMap[T, V]) Missing() []T {
+	missing := []T{}
+	for ordinal, s := range describe(reflect.TypeOf(*new(T))).ordered {
+		if !m.present.Has(ordinal) {
+			missing = append(missing, s.value.(T))
+		}
+	}
+	return missing
+}
+
+// Range calls f for each entry (in symbol order); f returns true to stop the iteration.
+func (m EnumMap[T, V]) Range(f func(k T, v V) (stop bool)) {
+	d := describe(reflect.TypeOf(*new(T)))
+	for _, ordinal := range m.present.Bits() {
+		if f(d.ordered[ordinal].value.(T), m.values[ordinal]) {
+			return
+		}
+	}
+}
+
+// MarshalJSON returns m as a JSON object whose keys are the symbols (as returned by String) in
+// symbol order (like {"Red":1,"Blue":3}).
+func (m EnumMap[T, V]) MarshalJSON() ([]byte, error) {
+	enumType := reflect.TypeOf(*new(T))
+	b, err := &bytes.Buffer{}, error(nil)
+	b.WriteByte('{')
+	m.Range(func(k T, v V) bool {
+		var key, value []byte
+		if key, err = json.Marshal(String(k, enumType)); err != nil {
+			return true
+		}
+		if value, err = json.Marshal(v); err != nil {
+			return true
+		}
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+		return false // Continue with the next entry
+	})
+	b.WriteByte('}')
+	return b.Bytes(), err
+}
+
+// UnmarshalJSON sets m to the entries in a JSON object whose keys are (case-insensitive)
+// symbols. It accepts any object that MarshalJSON returns, including one without an entry for
+// some of T's symbols; use Missing to check that m is complete.
+func (m *EnumMap[T, V]) UnmarshalJSON(data []byte) error {
+	entries := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	parsed := EnumMap[T, V]{}
+	for symbol, raw := range entries {
+		k, err := Parse(reflect.TypeOf(new(T)), symbol, true)
+		if err != nil {
+			return err
+		}
+		if _, ok := describe(reflect.TypeOf(k)).ordinals[k]; !ok {
+			return fmt.Errorf("couldn't parse %q into a %q: it has no symbol", symbol, reflect.TypeOf(k).Name())
+		}
+		var v V
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+		parsed.Set(k.(T), v)
+	}
+	*m = parsed
+	return nil
+}
@@ -0,0 +1,38 @@
+package enum_test
+
+import (
+	"encoding/json"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleEnumMap() {
+	hex, err := enum.NewEnumMap(map[Color]string{
+		EColor.None(): "", EColor.Red(): "#f00", EColor.Green(): "#0f0", EColor.Blue(): "#00f"})
+	printf("%v\n", err)
+	if v, ok := hex.Get(EColor.Green()); ok {
+		printf("Green is %s\n", v)
+	}
+	b, _ := json.Marshal(hex)
+	printf("%s\n", b)
+
+	_, err = enum.NewEnumMap(map[Color]string{EColor.Red(): "#f00"})
+	printf("%v\n", err)
+
+	partial := enum.EnumMap[Color, int]{}
+	partial.Set(EColor.Red(), 5)
+	b, _ = json.Marshal(partial)
+	err = json.Unmarshal(b, &partial)
+	printf("%s %v %d %v\n", b, err, partial.Len(), enum.NewEnumSet(partial.Missing()...))
+
+	err = json.Unmarshal([]byte(`{"none":"","purple":"#f0f"}`), &hex)
+	printf("%v\n", err)
+
+	// Output:
+	// <nil>
+	// Green is #0f0
+	// {"None":"","Red":"#f00","Green":"#0f0","Blue":"#00f"}
+	// EnumMap[Color] has no entry for None, Green, Blue
+	// {"Red":5} <nil> 1 None, Green, Blue
+	// couldn't parse "purple" into a "Color"
+}
@@ -0,0 +1,170 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// EnumSet is a compact set of an ordinary (non bit flags) enum type's values, like a set of
+// Colors; use it instead of a map[Color]bool. It is a bitset indexed by each symbol's ordinal (see
+// Index) so only values with a symbol can be members & its members are iterated in ordinal order
+// (sorted by value unless T has an EnumOrder method). Its zero value is an empty set.
+type EnumSet[T comparable] struct {
+	ordinals BigFlags[int] // Bit n is set if the symbol with ordinal n is a member
+}
+
+// NewEnumSet returns an EnumSet containing the specified values.
+func NewEnumSet[T comparable](values ...T) EnumSet[T] {
+	s := EnumSet[T]{}
+	s.Add(values...)
+	return s
+}
+
+// ordinalOf is an internal function that returns the ordinal of an enum value's symbol; it
+// panics if the value has no symbol.
+func ordinalOf[T comparable](v T) int {
+	ordinal, ok := describe(reflect.TypeOf(v)).ordinals[v]
+	if !ok {
+		panic(fmt.Sprintf("enum: %v is not a symbol of %s", underlyingString(reflect.ValueOf(v)), reflect.TypeOf(v)))
+	}
+	return ordinal
+}
+
+// Add adds the specified values to s; it panics if a value has no symbol.
+func (s *EnumSet[T]) Add(values ...T) {
+	for _, v := range values {
+		s.ordinals.Set(ordinalOf(v))
+	}
+}
+
+// Remove removes the specified values from s.
+func (s *EnumSet[T]) Remove(values ...T) {
+	d := describe(reflect.TypeOf(*new(T)))
+	for _, v := range values {
+		if ordinal, ok := d.ordinals[v]; ok {
+			s.ordinals.Clear(ordinal)
+		}
+	}
+}
+
+// Contains returns true if v is a member of s.
+func (s EnumSet[T]) Contains(v T) bool {
+	ordinal, ok := describe(reflect.TypeOf(v)).ordinals[v]
+	return ok && s.ordinals.Has(ordinal)
+}
+
+// Union returns the values that are members of s or s2.
+func (s EnumSet[T]) Union(s2 EnumSet[T]) EnumSet[T] {
+	return EnumSet[T]{ordinals: s.ordinals.Union(s2.ordinals)}
+}
+
+// Intersect returns the values that are members of both s and s2.
+func (s EnumSet[T]) Intersect(s2 EnumSet[T]) EnumSet[T] {
+	return EnumSet[T]{ordinals: s.ordinals.Intersect(s2.ordinals)}
+}
+
+// Equal returns true if s and s2 have the same members.
+func (s EnumSet[T]) Equal(s2 EnumSet[T]) bool {
+	return s.ordinals.Equal(s2.ordinals)
+}
+
+// Len returns the number of members in s.
+func (s EnumSet[T]) Len() int {
+	return s.ordinals.Len()
+}
+
+// Values returns s's members in symbol order.
+func (s EnumSet[T]) Values() []T {
+	d := describe(reflect.TypeOf(*new(T)))
+	values := make([]T, 0, s.Len())
+	for _, ordinal := range s.ordinals.Bits() {
+		values = append(values, d.ordered[ordinal].value.(T))
+	}
+	return values
+}
+
+// String returns s's members' symbols (in symbol order) separated by ", ".
+func (s EnumSet[T]) String() string {
+	return strings.Join(s.symbols(), ", ")
+}
+
+// symbols is an internal method that returns s's members' symbols (as returned by String).
+func (s EnumSet[T]) symbols() []string {
+	enumType := reflect.TypeOf(*new(T))
+	symbols := []string{}
+	for _, v := range s.Values() {
+		symbols = append(symbols, String(v, enumType))
+	}
+	return symbols
+}
+
+// MarshalText returns the same string as String.
+func (s EnumSet[T]) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText sets s to the (case-insensitive) comma-separated symbols in text.
+func (s *EnumSet[T]) UnmarshalText(text []byte) error {
+	parsed, err := ParseEnumSet[T](string(text), true)
+	if err == nil {
+		*s = parsed
+	}
+	return err
+}
+
+// MarshalJSON returns s's members' symbols as a JSON array (like ["Red","Blue"]).
+func (s EnumSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.symbols())
+}
+
+// UnmarshalJSON sets s to the (case-insensitive) symbols in a JSON array or comma-separated string.
+func (s *EnumSet[T]) UnmarshalJSON(data []byte) error {
+	symbols := []string{}
+	if err := json.Unmarshal(data, &symbols); err != nil {
+		text := ""
+		if json.Unmarshal(data, &text) != nil {
+			return err // Neither an array nor a string
+		}
+		return s.UnmarshalText([]byte(text))
+	}
+	parsed := EnumSet[T]{}
+	for _, symbol := range symbols {
+		if err := parsed.addSymbol(symbol, true); err != nil {
+			return err
+		}
+	}
+	*s = parsed
+	return nil
+}
+
+// addSymbol is an internal method that adds the value of symbol to s; it returns an error
+// (instead of panicking like Add) if symbol doesn't parse into a value with a symbol, like an
+// undeclared string accepted by an Open string enum type.
+func (s *EnumSet[T]) addSymbol(symbol string, caseInsensitive bool) error {
+	v, err := Parse(reflect.TypeOf(new(T)), symbol, caseInsensitive)
+	if err != nil {
+		return err
+	}
+	if _, ok := describe(reflect.TypeOf(v)).ordinals[v]; !ok {
+		return fmt.Errorf("couldn't parse %q into a %q: it has no symbol", symbol, reflect.TypeOf(v).Name())
+	}
+	s.Add(v.(T))
+	return nil
+}
+
+// ParseEnumSet parses a comma-separated string of T's symbols (like "Red, Blue") into an
+// EnumSet using Parse for each symbol.
+func ParseEnumSet[T comparable](s string, caseInsensitive bool) (EnumSet[T], error) {
+	set := EnumSet[T]{}
+	if strings.TrimSpace(s) == "" {
+		return set, nil // An empty set
+	}
+	for _, symbol := range strings.Split(s, ",") {
+		if err := set.addSymbol(strings.TrimSpace(symbol), caseInsensitive); err != nil {
+			return EnumSet[T]{}, err
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,38 @@
+package enum_test
+
+import (
+	"encoding/json"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleEnumSet() {
+	colors := enum.NewEnumSet(EColor.Blue(), EColor.Red())
+	printf("%s (%d)\n", colors, colors.Len())
+	printf("%t %t\n", colors.Contains(EColor.Red()), colors.Contains(EColor.Green()))
+
+	others, _ := enum.ParseEnumSet[Color]("green, RED", true)
+	printf("%s | %s\n", colors.Union(others), colors.Intersect(others))
+
+	b, _ := json.Marshal(colors)
+	printf("%s\n", b)
+	var fromJSON enum.EnumSet[Color]
+	_ = json.Unmarshal([]byte(`"blue, green"`), &fromJSON)
+	printf("%s\n", fromJSON)
+
+	_, err := enum.ParseEnumSet[Color]("Red, Purple", true)
+	printf("%v\n", err)
+
+	var regions enum.EnumSet[Region] // Open: Parse accepts unknown regions but a set can't hold them
+	err = json.Unmarshal([]byte(`["us-east", "mars"]`), &regions)
+	printf("%v\n", err)
+
+	// Output:
+	// Red, Blue (2)
+	// true false
+	// Red, Green, Blue | Red
+	// ["Red","Blue"]
+	// Green, Blue
+	// couldn't parse "Purple" into a "Color"
+	// couldn't parse "mars" into a "Region": it has no symbol
+}
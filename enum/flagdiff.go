@@ -0,0 +1,97 @@
+package enum
+
+import (
+	"log/slog"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FlagDiff describes how a bit flags value changed. Symbols are selected like FormatUintFlags'
+// Fewest style so a composite symbol (like ReadWrite) is reported if all of its bits changed.
+type FlagDiff struct {
+	Added       []string // The symbols whose bits were set
+	Removed     []string // The symbols whose bits were cleared
+	AddedBits   uint64   // Set bits that don't correspond to any symbol
+	RemovedBits uint64   // Cleared bits that don't correspond to any symbol
+}
+
+// DiffFlags returns the symbols (& undeclared bits) that were set and cleared when a bit flags
+// value changed from oldValue to newValue.
+func DiffFlags[T Integer](oldValue T, newValue T) FlagDiff {
+	enumType := reflect.TypeOf(oldValue)
+	o, n := flagBits(oldValue), flagBits(newValue)
+	d := FlagDiff{}
+	d.Added, d.AddedBits = diffSymbols(n&^o, enumType)
+	d.Removed, d.RemovedBits = diffSymbols(o&^n, enumType)
+	return d
+}
+
+// diffSymbols is an internal function that returns the fewest symbols covering changed's bits
+// and the changed bits that no symbol covers.
+func diffSymbols(changed uint64, enumType reflect.Type) (symbols []string, leftover uint64) {
+	symbols = []string{}
+	if changed == 0 {
+		return symbols, 0
+	}
+	d, naming := describe(enumType), namingOf(enumType)
+	chosen := fewestSymbols(changed, enumType)
+	leftover = changed
+	GetSymbols(enumType, func(symbolName string, symbolValue interface{}) bool {
+		if chosen[symbolName] {
+			symbols = append(symbols, d.name(naming, symbolName))
+			leftover &^= flagBits(symbolValue)
+		}
+		return false // Continue symbol enumeration
+	})
+	return symbols, leftover
+}
+
+// IsEmpty returns true if no bits changed.
+func (d FlagDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && d.AddedBits == 0 && d.RemovedBits == 0
+}
+
+// String returns the diff like "added Write; removed Execute".
+func (d FlagDiff) String() string {
+	return d.Format("added", "removed")
+}
+
+// Format returns the diff using the specified verbs; for example, Format("granted", "revoked")
+// returns "granted Write; revoked Execute". An empty diff returns "".
+func (d FlagDiff) Format(addedVerb string, removedVerb string) string {
+	parts := []string{}
+	if added := diffList(d.Added, d.AddedBits); added != "" {
+		parts = append(parts, addedVerb+" "+added)
+	}
+	if removed := diffList(d.Removed, d.RemovedBits); removed != "" {
+		parts = append(parts, removedVerb+" "+removed)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// diffList is an internal function that joins symbols & undeclared bits (in base 16).
+func diffList(symbols []string, leftover uint64) string {
+	if leftover != 0 {
+		symbols = append(symbols[:len(symbols):len(symbols)], "0x"+strconv.FormatUint(leftover, 16))
+	}
+	return strings.Join(symbols, ", ")
+}
+
+// LogValue implements slog.LogValuer so a FlagDiff is logged as a group of its non-empty fields.
+func (d FlagDiff) LogValue() slog.Value {
+	attrs := []slog.Attr{}
+	if len(d.Added) > 0 {
+		attrs = append(attrs, slog.Any("added", d.Added))
+	}
+	if len(d.Removed) > 0 {
+		attrs = append(attrs, slog.Any("removed", d.Removed))
+	}
+	if d.AddedBits != 0 {
+		attrs = append(attrs, slog.String("addedBits", "0x"+strconv.FormatUint(d.AddedBits, 16)))
+	}
+	if d.RemovedBits != 0 {
+		attrs = append(attrs, slog.String("removedBits", "0x"+strconv.FormatUint(d.RemovedBits, 16)))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,36 @@
+package enum_test
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleDiffFlags() {
+	oldAccess := EAccess.Read() | EAccess.Execute() | Access(0x100)
+	newAccess := EAccess.Read() | EAccess.Write()
+	diff := enum.DiffFlags(oldAccess, newAccess)
+	printf("%s\n", diff.Format("granted", "revoked"))
+	printf("%s\n", diff)
+	printf("%t\n", enum.DiffFlags(newAccess, newAccess).IsEmpty())
+
+	// Composite symbols are reported when all of their bits change
+	printf("%s\n", enum.DiffFlags(EFileMode.None(), EFileMode.Read()|EFileMode.Write()))
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{} // Omit the time so the output is predictable
+			}
+			return a
+		}}))
+	logger.Info("access changed", "user", "jeff", "access", diff)
+
+	// Output:
+	// granted Write; revoked Execute, 0x100
+	// added Write; removed Execute, 0x100
+	// true
+	// added ReadWrite
+	// level=INFO msg="access changed" user=jeff access.added=[Write] access.removed=[Execute] access.removedBits=0x100
+}
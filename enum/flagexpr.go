@@ -0,0 +1,161 @@
+package enum
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// parseExpression is an internal method that parses a flag expression.
+func (f FlagFormat) parseExpression(enumTypePtr reflect.Type, s string, caseInsensitive bool, parseSymbol func(symbol string) (interface{}, error)) (uint64, error) {
+	terms, err := f.splitTerms(s)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't parse %q into a %q: %w", s, enumTypePtr.Elem().Name(), err)
+	}
+	val := uint64(0)
+	for _, term := range terms {
+		negate := strings.HasPrefix(term, "-") || strings.HasPrefix(term, "!")
+		if negate {
+			term = strings.TrimSpace(term[1:])
+		}
+		bits, err := f.parseTerm(enumTypePtr, term, caseInsensitive, parseSymbol)
+		if err != nil {
+			return 0, err
+		}
+		if negate {
+			val &^= bits // Remove the term's bits
+		} else {
+			val |= bits // Add the term's bits
+		}
+	}
+	return val, nil
+}
+
+// parseTerm is an internal method that returns the bits of a flag expression's term (without
+// its negation prefix).
+func (f FlagFormat) parseTerm(enumTypePtr reflect.Type, term string, caseInsensitive bool, parseSymbol func(symbol string) (interface{}, error)) (uint64, error) {
+	enumType := enumTypePtr.Elem()
+	if strings.HasPrefix(term, "(") && strings.HasSuffix(term, ")") {
+		return f.parseExpression(enumTypePtr, term[1:len(term)-1], caseInsensitive, parseSymbol) // A group
+	}
+	if term == "*" {
+		return declaredBits(enumType), nil
+	}
+	if v, err := parseSymbol(term); err == nil {
+		return flagBits(v), nil // A symbol (checked before "all" in case a symbol is named All)
+	}
+	if strings.EqualFold(term, "all") {
+		return declaredBits(enumType), nil
+	}
+	if strings.ContainsAny(term, "*?[") {
+		return globBits(enumType, term, caseInsensitive)
+	}
+	return f.parseToken(enumTypePtr, term, caseInsensitive, parseSymbol)
+}
+
+// globBits is an internal function that returns the bits of the symbols whose names (method
+// names or names returned by String) match a glob pattern.
+func globBits(enumType reflect.Type, pattern string, caseInsensitive bool) (uint64, error) {
+	if caseInsensitive {
+		pattern = strings.ToLower(pattern)
+	}
+	d, n := describe(enumType), namingOf(enumType)
+	bits, matched, err := uint64(0), false, error(nil)
+	GetSymbols(enumType, func(symbolName string, symbolValue interface{}) bool {
+		for _, name := range []string{symbolName, d.name(n, symbolName)} {
+			if caseInsensitive {
+				name = strings.ToLower(name)
+			}
+			var ok bool
+			if ok, err = path.Match(pattern, name); err != nil {
+				return true // Stop, the pattern is malformed
+			}
+			if ok {
+				bits |= flagBits(symbolValue)
+				matched = true
+				break
+			}
+		}
+		return false // Continue symbol enumeration
+	})
+	switch {
+	case err != nil:
+		return 0, fmt.Errorf("couldn't parse %q into a %q: %w", pattern, enumType.Name(), err)
+	case !matched:
+		return 0, fmt.Errorf("couldn't parse %q into a %q: the pattern matches no symbols", pattern, enumType.Name())
+	}
+	return bits, nil
+}
+
+// splitTerms is an internal method that splits a flag expression into its (trimmed) terms using
+// f's separator; separators within parentheses don't split terms.
+func (f FlagFormat) splitTerms(s string) ([]string, error) {
+	terms, depth, start := []string{}, 0, 0
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth < 0 {
+				return nil, fmt.Errorf("unbalanced ')' at offset %d", i)
+			}
+		default:
+			if n := f.separatorLen(s[i:]); n > 0 && depth == 0 {
+				terms = append(terms, strings.TrimSpace(s[start:i]))
+				i += n
+				start = i
+				continue
+			}
+		}
+		i++
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced '('")
+	}
+	terms = append(terms, strings.TrimSpace(s[start:]))
+	if strings.TrimSpace(f.Separator) == "" { // Runs of whitespace separate terms
+		nonEmpty := terms[:0]
+		for _, t := range terms {
+			if t != "" {
+				nonEmpty = append(nonEmpty, t)
+			}
+		}
+		terms = nonEmpty
+	}
+	return terms, nil
+}
+
+// formatExpression is an internal method that returns the shorter of intValue's fewest symbols
+// (like "Read, Write") & all declared bits minus the missing symbols (like "all, -Execute").
+func (f FlagFormat) formatExpression(intValue uint64, enumType reflect.Type, name func(symbolName string) string) string {
+	list := f
+	list.Expressions, list.Style = false, EFlagStyle.Fewest()
+	shortest := list.format(intValue, enumType, name)
+
+	mask := declaredBits(enumType)
+	missing := mask &^ intValue
+	if intValue&mask == 0 || len(describe(enumType).fields) > 0 {
+		return shortest // "all" is of no use (or can't express multi-bit fields)
+	}
+	terms, removed := []string{"all"}, uint64(0)
+	chosen := fewestSymbols(missing, enumType)
+	GetSymbols(enumType, func(symbolName string, symbolValue interface{}) bool {
+		if chosen[symbolName] {
+			terms = append(terms, "-"+name(symbolName))
+			removed |= flagBits(symbolValue)
+		}
+		return false // Continue symbol enumeration
+	})
+	if removed != missing {
+		return shortest // The missing bits can't be removed using symbols that aren't in intValue
+	}
+	if leftover := intValue &^ mask; leftover != 0 {
+		terms = append(terms, f.prefix()+strconv.FormatUint(leftover, f.Base))
+	}
+	if complement := strings.Join(terms, f.Separator); len(complement) < len(shortest) {
+		return complement
+	}
+	return shortest
+}
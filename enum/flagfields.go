@@ -0,0 +1,89 @@
+package enum
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strings"
+)
+
+// Field describes a multi-bit field packed inside a bit flags value; the field's value is an
+// enum value of another type. For example, bits 4-6 of a header's flags might hold a Priority.
+type Field struct {
+	Name string       // The field's name (like "Priority")
+	Mask uint64       // The field's (contiguous) bits within the flags value (like 0x70)
+	Type reflect.Type // The field's enum type (like reflect.TypeOf(EPriority)); its values are shifted into Mask
+}
+
+// FlagFields is implemented by a bit flags enum type whose values contain multi-bit fields.
+// Formatting renders each non-zero field as "Name=Symbol" (like "Compressed, Priority=High") &
+// parsing accepts the same. For example:
+//
+//	func (Header) EnumFields() []enum.Field {
+//	   return []enum.Field{{Name: "Priority", Mask: 0x70, Type: reflect.TypeOf(EPriority)}}
+//	}
+type FlagFields interface {
+	EnumFields() []Field
+}
+
+// value is an internal method that returns the field's value (shifted down from its bits) in intValue.
+func (f Field) value(intValue uint64) uint64 {
+	return (intValue & f.Mask) >> bits.TrailingZeros64(f.Mask)
+}
+
+// fieldMask is an internal method that returns the bits of all of an enum type's fields.
+func (d *descriptor) fieldMask() uint64 {
+	mask := uint64(0)
+	for _, f := range d.fields {
+		mask |= f.Mask
+	}
+	return mask
+}
+
+// formatFields is an internal method that returns "Name=Symbol" (or "Name=Integer" if the
+// field's value has no symbol) for each of intValue's non-zero fields.
+func (d *descriptor) formatFields(intValue uint64) []string {
+	terms := []string{}
+	for _, f := range d.fields {
+		if v := f.value(intValue); v != 0 {
+			fieldValue := reflect.New(f.Type).Elem() // Create a field enum value & set it
+			if fieldValue.CanUint() {
+				fieldValue.SetUint(v)
+			} else {
+				fieldValue.SetInt(int64(v))
+			}
+			terms = append(terms, f.Name+"="+StringInt(fieldValue.Interface(), f.Type))
+		}
+	}
+	return terms
+}
+
+// parseField is an internal method that returns the bits of a "Name=Symbol" (or "Name=Integer")
+// token; found is false if the token doesn't name one of the enum type's fields.
+func (d *descriptor) parseField(enumType reflect.Type, token string, caseInsensitive bool) (fieldBits uint64, found bool, err error) {
+	name, symbol, ok := strings.Cut(token, "=")
+	if !ok {
+		return 0, false, nil
+	}
+	name, symbol = strings.TrimSpace(name), strings.TrimSpace(symbol)
+	for _, f := range d.fields {
+		if !Naming(nil).matches(f.Name, name, caseInsensitive) {
+			continue
+		}
+		v := uint64(0)
+		if fieldValue, err := ParseInt(reflect.PointerTo(f.Type), symbol, caseInsensitive, false); err != nil {
+			return 0, true, fmt.Errorf("couldn't parse %q into a %q: %w", token, enumType.Name(), err)
+		} else if rv := reflect.ValueOf(fieldValue); rv.CanUint() {
+			v = rv.Uint()
+		} else {
+			v = uint64(rv.Int())
+		}
+		shifted := v << bits.TrailingZeros64(f.Mask)
+		if shifted&^f.Mask != 0 || shifted>>bits.TrailingZeros64(f.Mask) != v {
+			return 0, true, fmt.Errorf("couldn't parse %q into a %q: %d doesn't fit in field %s's mask 0x%x",
+				token, enumType.Name(), v, f.Name, f.Mask)
+		}
+		return shifted, true, nil
+	}
+	return 0, false, nil
+}
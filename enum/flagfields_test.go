@@ -0,0 +1,56 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var EPriority = Priority(0).Low() // Helper variable used by consuming code (improves cross-package consumption)
+type Priority uint8               // Priority is packed into bits 4-6 of a Header
+
+// Define Priority's "symbols" and their values:
+func (Priority) Low() Priority    { return Priority(0) }
+func (Priority) Normal() Priority { return Priority(1) }
+func (Priority) High() Priority   { return Priority(2) }
+
+var EHeader = Header(0).None() // Helper variable used by consuming code (improves cross-package consumption)
+type Header uint16             // Header packs flags together with a Priority field
+
+// Define Header's "symbols" and their values:
+func (Header) None() Header       { return Header(0x00) }
+func (Header) Compressed() Header { return Header(0x01) }
+func (Header) Encrypted() Header  { return Header(0x02) }
+
+// EnumFields declares the multi-bit fields packed inside a Header
+func (Header) EnumFields() []enum.Field {
+	return []enum.Field{{Name: "Priority", Mask: 0x70, Type: reflect.TypeOf(EPriority)}}
+}
+
+// String converts a Header enum value to its equivalent "symbols" & fields (comma separated)
+func (h Header) String() string {
+	return enum.StringUintFlags(uint64(h), reflect.TypeOf(h), 16)
+}
+
+func ExampleFlagFields() {
+	for _, h := range []Header{EHeader.Compressed() | Header(EPriority.High())<<4, Header(EPriority.Normal()) << 4, Header(0x71), EHeader.None()} {
+		printf("%-30s ", h)
+		if v, err := enum.ParseUintFlags(reflect.TypeOf(&h), h.String(), true); err == nil {
+			printf("0x%02x\n", v) // Round-trip the string
+		} else {
+			printf("error: %s\n", err)
+		}
+	}
+	if _, err := enum.ParseUintFlags(reflect.TypeOf(&EHeader), "Encrypted, priority=9", true); err != nil {
+		printf("%s\n", err)
+	}
+	printf("%v\n", enum.ValidateFlags(reflect.TypeOf(EHeader)))
+
+	// Output:
+	// Compressed, Priority=High      0x21
+	// Priority=Normal                0x10
+	// Compressed, Priority=7         0x71
+	// None                           0x00
+	// couldn't parse "priority=9" into a "Header": 9 doesn't fit in field Priority's mask 0x70
+	// <nil>
+}
@@ -0,0 +1,350 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const EFlagStyle = FlagStyle(0) // Helper constant used by consuming code like EFlagStyle.Fewest() (a constant so it can't be reassigned)
+
+// FlagStyle determines which symbols are used to format a bit flags value. The examples show
+// the formatting of Read|Write for a type with Read, Write & ReadWrite (= Read|Write) symbols.
+type FlagStyle uint8
+
+// Define FlagStyle's "symbols" and their values:
+func (FlagStyle) All() FlagStyle    { return FlagStyle(0) } // Every symbol whose bits are set: "Read, ReadWrite, Write"
+func (FlagStyle) Fewest() FlagStyle { return FlagStyle(1) } // The fewest symbols, preferring composite symbols: "ReadWrite"
+func (FlagStyle) Bits() FlagStyle   { return FlagStyle(2) } // Only single-bit symbols: "Read, Write"
+
+// String converts a FlagStyle enum value to its equivalent "symbol"
+func (s FlagStyle) String() string { return StringInt(s, reflect.TypeOf(s)) }
+
+// includes is an internal method that returns true if a symbol (whose bits are all set in the
+// value being formatted) is part of the formatted string.
+func (s FlagStyle) includes(symbolName string, symbolValue uint64, fewest map[string]bool) bool {
+	switch s {
+	case EFlagStyle.Fewest():
+		return fewest[symbolName]
+	case EFlagStyle.Bits():
+		return bits.OnesCount64(symbolValue) == 1
+	default:
+		return true
+	}
+}
+
+// FlagFormat specifies how FormatUintFlags formats a bit flags value and how
+// ParseFormattedUintFlags parses it; a string formatted with a FlagFormat can be parsed with the
+// same FlagFormat. The zero value formats like StringUintFlags with base 16.
+type FlagFormat struct {
+	Style         FlagStyle // Which symbols to use (the default is All)
+	Separator     string    // The string between symbols like "|", " + " or " " (the default is ", "; parsing ignores the whitespace around it)
+	Base          int       // The base (2-36) of bits that don't correspond to any symbol (the default is 16)
+	OmitPrefix    bool      // If false, undeclared bits are prefixed with "0b", "0o" or "0x" (for bases 2, 8 & 16)
+	LeftoverFirst bool      // If true, undeclared bits precede the symbols (the default is to follow them)
+	ZeroName      string    // The name for 0 when the enum type has no symbol whose value is 0 (the default is "")
+	Strict        bool      // If true, parsing rejects integer strings (& therefore undeclared bits)
+	Expressions   bool      // If true, flag expressions (like "all, -Execute") are parsed & the shortest one is formatted
+}
+
+// FormatUintFlags is like StringUintFlags but formats intValue as specified by f.
+func FormatUintFlags(intValue uint64, enumType reflect.Type, f FlagFormat) string {
+	d, n := describe(enumType), namingOf(enumType)
+	return f.format(intValue, enumType, func(symbolName string) string { return d.name(n, symbolName) })
+}
+
+// ParseFormattedUintFlags is like ParseUintFlags but parses s as specified by f. Unlike
+// ParseUintFlags, an empty (or all whitespace) s returns 0 since FormatUintFlags formats 0 as ""
+// when the enum type has no symbol whose value is 0 & f has no ZeroName.
+//
+// If f.Expressions is true, s is a flag expression: a list of terms separated by f.Separator &
+// evaluated left to right. A term adds bits to the value or, if prefixed with '-' or '!',
+// removes bits from the value. A term is one of:
+//
+//	Symbol    a symbol (or integer string) as accepted by ParseUintFlags
+//	* or all  every bit declared by the enum type's symbols
+//	Blob*     a glob pattern (see path.Match) matching symbol names
+//	(A, B)    a parenthesized flag expression
+//
+// For example, "all, -Execute" or "Blob*, !BlobDeleted".
+func ParseFormattedUintFlags(enumTypePtr reflect.Type, s string, caseInsensitive bool, f FlagFormat) (uint64, error) {
+	n := namingOf(enumTypePtr)
+	return f.parse(enumTypePtr, s, caseInsensitive, func(symbol string) (interface{}, error) {
+		return n.Parse(enumTypePtr, symbol, caseInsensitive)
+	})
+}
+
+// withDefaults is an internal method that returns f with its unset fields set to their defaults.
+func (f FlagFormat) withDefaults() FlagFormat {
+	if f.Separator == "" {
+		f.Separator = ", "
+	}
+	if f.Base == 0 {
+		f.Base = 16
+	}
+	return f
+}
+
+// prefix is an internal method that returns the prefix for undeclared bits formatted in f's base.
+func (f FlagFormat) prefix() string {
+	if f.OmitPrefix {
+		return ""
+	}
+	return map[int]string{2: "0b", 8: "0o", 16: "0x"}[f.Base] // Other bases have no prefix
+}
+
+// format is an internal method that implements FormatUintFlags & StringUintFlags; it converts
+// each symbol method name to a string using name.
+func (f FlagFormat) format(intValue uint64, enumType reflect.Type, name func(symbolName string) string) string {
+	// Call flag's methods that return a flag
+	// if flag == 0, return symbol/method that returns 0
+	// else skip any method/symbol that returns 0; concatenate to string any method whose return value & f == method's return value
+	// return string
+	f = f.withDefaults()
+	if f.Expressions {
+		return f.formatExpression(intValue, enumType, name)
+	}
+	intValue &= widthMask(enumType) // Discard the sign extension of a negative signed value
+	d := describe(enumType)
+	fields := d.formatFields(intValue) // Format the multi-bit fields separately from the flags
+	if intValue &^= d.fieldMask(); intValue == 0 && len(fields) > 0 {
+		return strings.Join(fields, f.Separator)
+	}
+	return f.formatSymbols(intValue, flagSymbolsOf(enumType), fields, name)
+}
+
+// formatSymbols is an internal method that formats intValue's bits (which don't include any
+// multi-bit fields) using symbols followed by the already formatted fields.
+func (f FlagFormat) formatSymbols(intValue uint64, symbols []flagSymbol, fields []string, name func(symbolName string) string) string {
+	bitsFound := uint64(0)
+	symbolNames := []string{}
+	fewest := map[string]bool{}
+	if f.Style == EFlagStyle.Fewest() {
+		fewest = fewestOf(intValue, symbols)
+	}
+	for _, s := range symbols {
+		if intValue == 0 && s.value == 0 {
+			symbolNames = append(symbolNames, name(s.name)) // We found a match, return the method's name (the enum's symbol)
+			break
+		}
+		if s.value != 0 && (intValue&s.value == s.value) && f.Style.includes(s.name, s.value, fewest) {
+			bitsFound |= s.value
+			symbolNames = append(symbolNames, name(s.name))
+		}
+	}
+	if intValue == 0 && len(symbolNames) == 0 && f.ZeroName != "" {
+		return f.ZeroName // There's no zero symbol, use the zero name
+	}
+	symbolNames = append(symbolNames, fields...)
+	if bitsFound != intValue {
+		// Some bits in the original value were not accounted for, add the remaining value
+		leftover := f.prefix() + strconv.FormatUint(intValue&^bitsFound, f.Base)
+		if f.LeftoverFirst {
+			symbolNames = append([]string{leftover}, symbolNames...)
+		} else {
+			symbolNames = append(symbolNames, leftover)
+		}
+	}
+	return strings.Join(symbolNames, f.Separator) // Returns "" if intValue is 0 & there's no zero symbol
+}
+
+// parse is an internal method that implements ParseFormattedUintFlags & ParseUintFlags; it
+// converts each symbol to its value using parseSymbol.
+func (f FlagFormat) parse(enumTypePtr reflect.Type, s string, caseInsensitive bool, parseSymbol func(symbol string) (interface{}, error)) (uint64, error) {
+	f = f.withDefaults()
+	if strings.TrimSpace(s) == "" {
+		return 0, nil // A value with no symbols (formatted when there's no zero symbol)
+	}
+	if f.Expressions {
+		return f.parseExpression(enumTypePtr, s, caseInsensitive, parseSymbol)
+	}
+	val := uint64(0)
+	for _, token := range f.split(s) {
+		v, err := f.parseToken(enumTypePtr, token, caseInsensitive, parseSymbol)
+		if err != nil {
+			return 0, err
+		}
+		val |= v
+	}
+	return val, nil
+}
+
+// separatorLen is an internal method that returns the length of the separator (and the
+// whitespace around it) that s starts with or 0 if it doesn't start with one. Parsing ignores
+// whitespace around f's separator so " | " also accepts "|" and ", " also accepts ","; a
+// whitespace separator matches a run of whitespace and a word separator like " et " requires
+// whitespace around the word so that a name like "Complet" isn't split.
+func (f FlagFormat) separatorLen(s string) int {
+	sep := strings.TrimSpace(f.Separator)
+	lead := len(s) - len(strings.TrimLeftFunc(s, unicode.IsSpace))
+	if sep == "" {
+		return lead // The separator is whitespace
+	}
+	if !strings.HasPrefix(s[lead:], sep) {
+		return 0
+	}
+	after := s[lead+len(sep):]
+	trail := len(after) - len(strings.TrimLeftFunc(after, unicode.IsSpace))
+	first, _ := utf8.DecodeRuneInString(sep)
+	last, _ := utf8.DecodeLastRuneInString(sep)
+	if (isWordRune(first) && lead == 0) || (isWordRune(last) && trail == 0) {
+		return 0 // A word separator must be surrounded by whitespace
+	}
+	return lead + len(sep) + trail
+}
+
+// isWordRune is an internal function that returns true if r can be part of a word.
+func isWordRune(r rune) bool { return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) }
+
+// split is an internal method that splits s into its (trimmed) symbols using f's separator.
+func (f FlagFormat) split(s string) []string {
+	if strings.TrimSpace(f.Separator) == "" {
+		return strings.Fields(s) // The separator is whitespace
+	}
+	tokens, start := []string{}, 0
+	for i := 0; i < len(s); {
+		if n := f.separatorLen(s[i:]); n > 0 {
+			tokens = append(tokens, strings.TrimSpace(s[start:i]))
+			i += n
+			start = i
+			continue
+		}
+		i++
+	}
+	return append(tokens, strings.TrimSpace(s[start:]))
+}
+
+// parseToken is an internal method that returns the bits of a symbol, the zero name or (unless
+// strict) an integer string.
+func (f FlagFormat) parseToken(enumTypePtr reflect.Type, token string, caseInsensitive bool, parseSymbol func(symbol string) (interface{}, error)) (uint64, error) {
+	if f.ZeroName != "" && Naming(nil).matches(f.ZeroName, token, caseInsensitive) {
+		return 0, nil // The zero name adds no bits
+	}
+	if bits, found, err := describe(enumTypePtr).parseField(enumTypePtr.Elem(), token, caseInsensitive); found {
+		return bits, err // A multi-bit field like "Priority=High"
+	}
+	if v, err := parseSymbol(token); err == nil {
+		return flagBits(v), nil // Symbol found, return its value
+	}
+	strict := f.Strict || describe(enumTypePtr).mode == EMode.Closed() // Closed enum types don't accept integer strings
+	return f.parseUndeclared(token, enumTypePtr.Elem().Name(), int(enumTypePtr.Elem().Size())*8, strict, declaredBits(enumTypePtr.Elem()))
+}
+
+// parseUndeclared is an internal method that parses an integer string token (of a type whose
+// symbols declare the declared bits) unless strict.
+func (f FlagFormat) parseUndeclared(token string, typeName string, bitSize int, strict bool, declared uint64) (uint64, error) {
+	// Try to parse token as a string of digits into a uint64
+	i, err := f.parseBits(token, bitSize)
+	switch {
+	case errors.Is(err, strconv.ErrRange):
+		return 0, fmt.Errorf("couldn't parse %q into a %q: the value overflows %d bits", token, typeName, bitSize)
+	case err != nil:
+		return 0, fmt.Errorf("couldn't parse %q into a %q", token, typeName)
+	case strict:
+		if undeclared := i &^ declared; undeclared != 0 {
+			return 0, fmt.Errorf("couldn't parse %q into a %q: bits 0x%x have no symbol", token, typeName, undeclared)
+		}
+		return 0, fmt.Errorf("couldn't parse %q into a %q: strict parsing requires symbols", token, typeName)
+	default:
+		return i, nil // Successful parse, return its value
+	}
+}
+
+// parseBits is an internal method that parses undeclared bits formatted by f.
+func (f FlagFormat) parseBits(token string, bitSize int) (uint64, error) {
+	if f.prefix() == "" {
+		return strconv.ParseUint(token, f.Base, bitSize) // No prefix, the token must be in f's base
+	}
+	return strconv.ParseUint(token, 0, bitSize) // The prefix (if any) determines the base
+}
+
+// flagSymbol is an internal type holding a bit flag symbol's name & value.
+type flagSymbol struct {
+	name  string
+	value uint64
+}
+
+// flagSymbolsOf is an internal function that returns a bit flags enum type's symbols & their bits.
+func flagSymbolsOf(enumType reflect.Type) []flagSymbol {
+	symbols := []flagSymbol{}
+	GetSymbols(enumType, func(symbolName string, symbolValue interface{}) bool {
+		symbols = append(symbols, flagSymbol{symbolName, flagBits(symbolValue)})
+		return false // Continue symbol enumeration
+	})
+	return symbols
+}
+
+// fewestSymbols is an internal function that returns the fewest of a bit flags enum type's
+// symbols covering intValue's bits (see fewestOf).
+func fewestSymbols(intValue uint64, enumType reflect.Type) map[string]bool {
+	return fewestOf(intValue, flagSymbolsOf(enumType))
+}
+
+// fewestOf is an internal function that greedily selects the symbols covering intValue's bits,
+// trying symbols with more bits (composite symbols) first and skipping symbols that add no bits
+// to those already selected.
+func fewestOf(intValue uint64, symbols []flagSymbol) map[string]bool {
+	candidates := []flagSymbol{}
+	for _, s := range symbols {
+		if s.value != 0 && intValue&s.value == s.value {
+			candidates = append(candidates, s)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return bits.OnesCount64(candidates[i].value) > bits.OnesCount64(candidates[j].value)
+	})
+	chosen, covered := map[string]bool{}, uint64(0)
+	for _, c := range candidates {
+		if c.value&^covered != 0 { // The symbol adds bits not yet covered
+			chosen[c.name] = true
+			covered |= c.value
+		}
+	}
+	return chosen
+}
+
+// ValidateFlags returns an error if any of a bit flags enum type's composite symbols partially
+// overlap (like ReadWrite & WriteExecute); formatting with the Fewest style is then ambiguous.
+// An error is also returned if a symbol overlaps a multi-bit field or if fields overlap.
+func ValidateFlags(enumType reflect.Type) error {
+	symbols := flagSymbolsOf(enumType)
+	errs := overlapErrors(enumType.Name(), symbols)
+	fields := describe(enumType).fields
+	for i, f := range fields {
+		if shifted := f.Mask >> bits.TrailingZeros64(f.Mask); shifted&(shifted+1) != 0 {
+			errs = append(errs, fmt.Errorf("%s field %q's mask 0x%x isn't contiguous", enumType.Name(), f.Name, f.Mask))
+		}
+		for _, s := range symbols {
+			if s.value&f.Mask != 0 {
+				errs = append(errs, fmt.Errorf("%s flag %q (0x%x) overlaps field %q (0x%x)", enumType.Name(), s.name, s.value, f.Name, f.Mask))
+			}
+		}
+		for _, f2 := range fields[i+1:] {
+			if f.Mask&f2.Mask != 0 {
+				errs = append(errs, fmt.Errorf("%s fields %q (0x%x) and %q (0x%x) overlap", enumType.Name(), f.Name, f.Mask, f2.Name, f2.Mask))
+			}
+		}
+	}
+	return errors.Join(errs...) // nil if no symbols or fields overlap
+}
+
+// overlapErrors is an internal function that returns an error for each pair of symbols whose
+// bits partially overlap.
+func overlapErrors(typeName string, symbols []flagSymbol) []error {
+	errs := []error{}
+	for i, a := range symbols {
+		for _, b := range symbols[i+1:] {
+			if overlap := a.value & b.value; overlap != 0 && overlap != a.value && overlap != b.value {
+				errs = append(errs, fmt.Errorf("%s flags %q (0x%x) and %q (0x%x) partially overlap",
+					typeName, a.name, a.value, b.name, b.value))
+			}
+		}
+	}
+	return errs
+}
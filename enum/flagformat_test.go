@@ -0,0 +1,161 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var EFileMode = FileMode(0).None() // Helper variable used by consuming code (improves cross-package consumption)
+type FileMode uint8                // FileMode has composite symbols
+
+// Define FileMode's "symbols" and their values:
+func (FileMode) None() FileMode         { return FileMode(0x0) }
+func (FileMode) Read() FileMode         { return FileMode(0x1) }
+func (FileMode) Write() FileMode        { return FileMode(0x2) }
+func (FileMode) Execute() FileMode      { return FileMode(0x4) }
+func (FileMode) ReadWrite() FileMode    { return FileMode(0x3) } // Composite: Read|Write
+func (FileMode) WriteExecute() FileMode { return FileMode(0x6) } // Composite: Write|Execute (partially overlaps ReadWrite)
+
+func ExampleFormatUintFlags() {
+	for _, m := range []FileMode{EFileMode.Read() | EFileMode.Write(), EFileMode.Read() | EFileMode.Execute() | FileMode(0x10)} {
+		for _, style := range []enum.FlagStyle{enum.EFlagStyle.All(), enum.EFlagStyle.Fewest(), enum.EFlagStyle.Bits()} {
+			printf("%-6s %s\n", style, enum.FormatUintFlags(uint64(m), reflect.TypeOf(m), enum.FlagFormat{Style: style}))
+		}
+	}
+	printf("%v\n", enum.ValidateFlags(reflect.TypeOf(EFileMode)))
+
+	// Output:
+	// All    Read, ReadWrite, Write
+	// Fewest ReadWrite
+	// Bits   Read, Write
+	// All    Execute, Read, 0x10
+	// Fewest Execute, Read, 0x10
+	// Bits   Execute, Read, 0x10
+	// FileMode flags "ReadWrite" (0x3) and "WriteExecute" (0x6) partially overlap
+}
+
+var EEvent = Event(0).BlobCreated() // Helper variable used by consuming code (improves cross-package consumption)
+type Event uint16                   // Event has no symbol whose value is 0
+
+// Define Event's "symbols" and their values:
+func (Event) BlobCreated() Event      { return Event(0x1) }
+func (Event) BlobDeleted() Event      { return Event(0x2) }
+func (Event) ContainerCreated() Event { return Event(0x4) }
+func (Event) ContainerDeleted() Event { return Event(0x8) }
+
+func ExampleFlagFormat() {
+	formats := []enum.FlagFormat{
+		{},
+		{Separator: "|", Base: 2},
+		{Separator: " + ", Base: 10, LeftoverFirst: true, ZeroName: "NoEvents"},
+		{Separator: " ", Base: 8, OmitPrefix: true},
+	}
+	for _, f := range formats {
+		for _, e := range []Event{EEvent.BlobDeleted() | EEvent.ContainerCreated() | Event(0x100), Event(0)} {
+			s := enum.FormatUintFlags(uint64(e), reflect.TypeOf(e), f)
+			v, err := enum.ParseFormattedUintFlags(reflect.TypeOf(&e), s, false, f) // Round-trip the string
+			printf("%-40q 0x%x %v\n", s, v, err)
+		}
+	}
+	printf("%s\n", enum.StringUintFlags(uint64(Event(0x103)), reflect.TypeOf(EEvent), 10)) // Base 10 has no prefix
+
+	// Output:
+	// "BlobDeleted, ContainerCreated, 0x100"   0x106 <nil>
+	// ""                                       0x0 <nil>
+	// "BlobDeleted|ContainerCreated|0b100000000" 0x106 <nil>
+	// ""                                       0x0 <nil>
+	// "256 + BlobDeleted + ContainerCreated"   0x106 <nil>
+	// "NoEvents"                               0x0 <nil>
+	// "BlobDeleted ContainerCreated 400"       0x106 <nil>
+	// ""                                       0x0 <nil>
+	// BlobCreated, BlobDeleted, 256
+}
+
+func ExampleFlagFormat_strict() {
+	for _, strict := range []bool{false, true} {
+		for _, s := range []string{"read, write", "read, 0x2", "read, 0x100", "read, 0x100000000", "read, 0x"} {
+			v, err := enum.ParseFormattedUintFlags(reflect.TypeOf(&EAccess), s, true, enum.FlagFormat{Strict: strict})
+			if err == nil {
+				printf("strict=%-5t %s\n", strict, Access(v))
+			} else {
+				printf("strict=%-5t error: %s\n", strict, err)
+			}
+		}
+	}
+
+	// Output:
+	// strict=false Read, Write
+	// strict=false Read, Write
+	// strict=false Read, 0x100
+	// strict=false error: couldn't parse "0x100000000" into a "Access": the value overflows 32 bits
+	// strict=false error: couldn't parse "0x" into a "Access"
+	// strict=true  Read, Write
+	// strict=true  error: couldn't parse "0x2" into a "Access": strict parsing requires symbols
+	// strict=true  error: couldn't parse "0x100" into a "Access": bits 0x100 have no symbol
+	// strict=true  error: couldn't parse "0x100000000" into a "Access": the value overflows 32 bits
+	// strict=true  error: couldn't parse "0x" into a "Access"
+}
+
+func ExampleFlagFormat_empty() {
+	for _, s := range []string{"", "Read,Write", "Read,,Write", "Read|Write"} {
+		v, err := enum.ParseUintFlags(reflect.TypeOf(&EAccess), s, false)
+		printf("ParseUintFlags(%q): %s %v\n", s, Access(v), err)
+	}
+	for _, s := range []string{"", "Read||Write"} {
+		v, err := enum.ParseFormattedUintFlags(reflect.TypeOf(&EAccess), s, false, enum.FlagFormat{Separator: "|"})
+		printf("ParseFormattedUintFlags(%q): %s %v\n", s, Access(v), err)
+	}
+
+	// Output:
+	// ParseUintFlags(""): None couldn't parse "" into a "Access"
+	// ParseUintFlags("Read,Write"): Read, Write <nil>
+	// ParseUintFlags("Read,,Write"): None couldn't parse "" into a "Access"
+	// ParseUintFlags("Read|Write"): None couldn't parse "Read|Write" into a "Access"
+	// ParseFormattedUintFlags(""): None <nil>
+	// ParseFormattedUintFlags("Read||Write"): None couldn't parse "" into a "Access"
+}
+
+// Parsing ignores the whitespace around a separator but a word separator must be surrounded by whitespace
+func ExampleFlagFormat_separators() {
+	for _, f := range []enum.FlagFormat{{Separator: " | "}, {Separator: " + "}, {Separator: " et "}} {
+		printf("%q formats %q\n", f.Separator, enum.FormatUintFlags(0x3, reflect.TypeOf(EAccess), f))
+		for _, s := range []string{"0x1|0x2", "Read|Write", "Read+Write", "Read  +  Write", "Read et Write", "Read etWrite"} {
+			if v, err := enum.ParseFormattedUintFlags(reflect.TypeOf(&EAccess), s, false, f); err == nil {
+				printf("   %-16q %s\n", s, Access(v))
+			}
+		}
+	}
+
+	// Output:
+	// " | " formats "Read | Write"
+	//    "0x1|0x2"        Read, Write
+	//    "Read|Write"     Read, Write
+	// " + " formats "Read + Write"
+	//    "Read+Write"     Read, Write
+	//    "Read  +  Write" Read, Write
+	// " et " formats "Read et Write"
+	//    "Read et Write"  Read, Write
+}
+
+func ExampleFlagFormat_expressions() {
+	f := enum.FlagFormat{Expressions: true}
+	for _, s := range []string{"all, -execute", "*, !write, !read", "blob*", "container*, -(containerdeleted, 0x1), blobcreated", "blob*, nothing*"} {
+		if v, err := enum.ParseFormattedUintFlags(reflect.TypeOf(&EAccess), s, true, f); err == nil {
+			printf("%-55q %s\n", s, enum.FormatUintFlags(v, reflect.TypeOf(EAccess), f))
+		} else if v, err := enum.ParseFormattedUintFlags(reflect.TypeOf(&EEvent), s, true, f); err == nil {
+			printf("%-55q %s\n", s, enum.FormatUintFlags(v, reflect.TypeOf(EEvent), f))
+		} else {
+			printf("%-55q error: %s\n", s, err)
+		}
+	}
+	printf("%s\n", enum.FormatUintFlags(uint64(Event(0x10d)), reflect.TypeOf(EEvent), enum.FlagFormat{Expressions: true, Separator: "|"}))
+
+	// Output:
+	// "all, -execute"                                         Read, Write
+	// "*, !write, !read"                                      Execute
+	// "blob*"                                                 BlobCreated, BlobDeleted
+	// "container*, -(containerdeleted, 0x1), blobcreated"     BlobCreated, ContainerCreated
+	// "blob*, nothing*"                                       error: couldn't parse "nothing*" into a "Event": the pattern matches no symbols
+	// all|-BlobDeleted|0x100
+}
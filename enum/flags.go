@@ -0,0 +1,119 @@
+package enum
+
+import (
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+// Unsigned is satisfied by bit flag enum types (whose underlying type must be an unsigned integer).
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Has returns true if all of the bits in flags are set in v.
+func Has[T Unsigned](v T, flags T) bool { return v&flags == flags }
+
+// HasAny returns true if any of the bits in flags are set in v.
+func HasAny[T Unsigned](v T, flags T) bool { return v&flags != 0 }
+
+// Set returns v with the bits in flags set.
+func Set[T Unsigned](v T, flags T) T { return v | flags }
+
+// Clear returns v with the bits in flags cleared.
+func Clear[T Unsigned](v T, flags T) T { return v &^ flags }
+
+// Toggle returns v with the bits in flags inverted.
+func Toggle[T Unsigned](v T, flags T) T { return v ^ flags }
+
+// Union returns the bits set in any of the values.
+func Union[T Unsigned](values ...T) T {
+	u := T(0)
+	for _, v := range values {
+		u |= v
+	}
+	return u
+}
+
+// Intersect returns the bits set in all of the values (0 if there are no values).
+func Intersect[T Unsigned](values ...T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	i := values[0]
+	for _, v := range values[1:] {
+		i &= v
+	}
+	return i
+}
+
+// Difference returns the bits set in v that are not set in flags.
+func Difference[T Unsigned](v T, flags T) T { return v &^ flags }
+
+// Mask returns the bits declared by T's symbols OR'd together.
+func Mask[T Unsigned]() T {
+	return T(declaredBits(reflect.TypeOf(T(0))))
+}
+
+// Complement returns the bits declared by T's symbols that are not set in v; undeclared bits
+// are never set in the result.
+func Complement[T Unsigned](v T) T { return Mask[T]() &^ v }
+
+// PopCount returns the number of bits set in v.
+func PopCount[T Unsigned](v T) int { return bits.OnesCount64(uint64(v)) }
+
+// Split returns the values of T's symbols whose bits are all set in v (the symbols that
+// StringUintFlags returns, in the same order, including multi-bit symbols like ReadWrite) and the
+// leftover bits that no symbol declares. OR-ing the symbols & leftover together produces v.
+func Split[T Unsigned](v T) (symbols []T, leftover T) {
+	u, found := uint64(v), uint64(0)
+	symbols = []T{}
+	for _, s := range flagSymbolsOf(reflect.TypeOf(v)) {
+		if u == 0 && s.value == 0 {
+			symbols = append(symbols, T(s.value)) // v is the zero symbol's value
+			break
+		}
+		if s.value != 0 && u&s.value == s.value {
+			found |= s.value
+			symbols = append(symbols, T(s.value))
+		}
+	}
+	return symbols, T(u &^ found)
+}
+
+// SplitBits returns each bit set in v as a separate value (in ascending bit order) whether or not
+// a symbol declares it; unlike Split, it never returns a multi-bit symbol's value. OR-ing the
+// returned values together produces v.
+func SplitBits[T Unsigned](v T) []T {
+	values := make([]T, 0, PopCount(v))
+	for bit := range Bits(v) {
+		values = append(values, bit)
+	}
+	return values
+}
+
+// flagBits is an internal function that returns a bit flags enum value's bits; a signed value's
+// bits are its two's complement representation within its type's size.
+func flagBits(enumValue interface{}) uint64 {
+	v := reflect.ValueOf(enumValue)
+	if v.CanUint() {
+		return v.Uint()
+	}
+	return uint64(v.Int()) & widthMask(v.Type())
+}
+
+// widthMask is an internal function that returns a mask of the bits in an integer enum type.
+func widthMask(enumType reflect.Type) uint64 {
+	return math.MaxUint64 >> (64 - enumType.Size()*8)
+}
+
+// declaredBits is an internal function that returns the bits of a bit flags enum type's symbols
+// OR'd together.
+func declaredBits(enumType reflect.Type) uint64 {
+	mask := uint64(0)
+	GetSymbols(enumType, func(enumSymbolName string, enumSymbolValue interface{}) bool {
+		mask |= flagBits(enumSymbolValue)
+		return false // Continue symbol enumeration
+	})
+	return mask
+}
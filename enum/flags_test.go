@@ -0,0 +1,46 @@
+package enum_test
+
+import (
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleHas() {
+	a := EAccess.Read() | EAccess.Write()
+	printf("Has Read+Write: %t\n", enum.Has(a, EAccess.Read()|EAccess.Write()))
+	printf("Has Read+Execute: %t\n", enum.Has(a, EAccess.Read()|EAccess.Execute()))
+	printf("HasAny Read+Execute: %t\n", enum.HasAny(a, EAccess.Read()|EAccess.Execute()))
+	printf("Set Execute: %s\n", enum.Set(a, EAccess.Execute()))
+	printf("Clear Write: %s\n", enum.Clear(a, EAccess.Write()))
+	printf("Toggle Write+Execute: %s\n", enum.Toggle(a, EAccess.Write()|EAccess.Execute()))
+	printf("Union: %s\n", enum.Union(EAccess.Read(), EAccess.Execute(), Access(0x100)))
+	printf("Intersect: %s\n", enum.Intersect(a, EAccess.Write()|EAccess.Execute()))
+	printf("Difference: %s\n", enum.Difference(a, EAccess.Read()))
+	printf("Mask: %s\n", enum.Mask[Access]())
+	printf("Complement: %s\n", enum.Complement(a|Access(0x100))) // Undeclared bits are never set
+	printf("PopCount: %d\n", enum.PopCount(a|Access(0x100)))
+	symbols, leftover := enum.Split(a | Access(0x100))
+	printf("Split: %v %#x\n", symbols, uint32(leftover))
+	printf("SplitBits: %v\n", enum.SplitBits(a|Access(0x100)))
+	symbols2, leftover2 := enum.Split(EFileMode.ReadWrite() | EFileMode.Execute())
+	printf("Split: %v %#x\n", symbols2, uint8(leftover2))
+	symbols2, _ = enum.Split(EFileMode.None())
+	printf("Split: %v\n", symbols2)
+
+	// Output:
+	// Has Read+Write: true
+	// Has Read+Execute: false
+	// HasAny Read+Execute: true
+	// Set Execute: Execute, Read, Write
+	// Clear Write: Read
+	// Toggle Write+Execute: Execute, Read
+	// Union: Execute, Read, 0x100
+	// Intersect: Write
+	// Difference: Write
+	// Mask: Execute, Read, Write
+	// Complement: Execute
+	// PopCount: 3
+	// Split: [Read Write] 0x100
+	// SplitBits: [Read Write 0x100]
+	// Split: [4 1 3 2 6] 0x0
+	// Split: [0]
+}
@@ -0,0 +1,111 @@
+package enum
+
+import (
+	"iter"
+	"reflect"
+)
+
+// All returns an iterator over T's symbol method names & values (in the same order as
+// GetSymbols). For example:
+//
+//	for name, color := range enum.All[Color]() { ... }
+func All[T comparable]() iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		GetSymbols(reflect.TypeOf(*new(T)), func(enumSymbolName string, enumSymbolValue interface{}) bool {
+			return !yield(enumSymbolName, enumSymbolValue.(T)) // Stop if the loop body broke out
+		})
+	}
+}
+
+// Values returns an iterator over T's symbols' values (in the same order as GetSymbols); a value
+// is produced once per symbol so symbols with the same value produce it more than once.
+func Values[T comparable]() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range All[T]() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedAll returns an iterator over T's distinct symbol values (sorted by value, even if T has
+// an EnumOrder method) & their symbol method names; a value with several symbols is named by a
+// symbol that is not deprecated.
+func SortedAll[T comparable]() iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		yieldSymbols(describe(reflect.TypeOf(*new(T))).sorted, yield)
+	}
+}
+
+// SortedValues returns an iterator over T's distinct symbol values sorted by value.
+func SortedValues[T comparable]() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range SortedAll[T]() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// OrdinalAll returns an iterator over T's distinct symbol values in ordinal order (as declared by
+// T's EnumOrder method or else sorted by value) & their symbol method names; a value's ordinal
+// is its position in this order (see Index).
+func OrdinalAll[T comparable]() iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		yieldSymbols(describe(reflect.TypeOf(*new(T))).ordered, yield)
+	}
+}
+
+// OrdinalValues returns an iterator over T's distinct symbol values in ordinal order.
+func OrdinalValues[T comparable]() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range OrdinalAll[T]() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// yieldSymbols is an internal function that yields each symbol's name & value until yield
+// returns false.
+func yieldSymbols[T comparable](symbols []orderedSymbol, yield func(string, T) bool) {
+	for _, s := range symbols {
+		if !yield(s.name, s.value.(T)) {
+			return
+		}
+	}
+}
+
+// Bits returns an iterator over each bit set in a bit flags value (in ascending bit order) like
+// SplitBits does; the bits are yielded whether or not a symbol declares them.
+func Bits[T Unsigned](v T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for u := uint64(v); u != 0; u &= u - 1 { // Clear the lowest set bit each iteration
+			if !yield(T(u & -u)) { // Isolate the lowest set bit
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over s's members in symbol order.
+func (s EnumSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		d := describe(reflect.TypeOf(*new(T)))
+		for _, ordinal := range s.ordinals.Bits() {
+			if !yield(d.ordered[ordinal].value.(T)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over m's entries in symbol order.
+func (m EnumMap[T, V]) All() iter.Seq2[T, V] {
+	return func(yield func(T, V) bool) {
+		m.Range(func(k T, v V) bool { return !yield(k, v) })
+	}
+}
@@ -0,0 +1,33 @@
+package enum_test
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleAll() {
+	for name, color := range enum.SortedAll[Color]() {
+		printf("%-5s %d\n", name, color)
+	}
+	byName := maps.Collect(enum.All[Color]())
+	printf("%d\n", byName["Green"])
+	printf("%v\n", slices.Collect(enum.SortedValues[Color]()))
+	printf("%t\n", slices.Contains(slices.Collect(enum.Values[Color]()), EColor.Blue()))
+
+	for bit := range enum.Bits(EAccess.Read() | EAccess.Execute() | Access(0x100)) {
+		printf("%s ", bit)
+	}
+	printf("\n")
+
+	// Output:
+	// None  0
+	// Red   1
+	// Green 2
+	// Blue  3
+	// 2
+	// [None Red Green Blue]
+	// true
+	// Read Execute 0x100
+}
@@ -0,0 +1,272 @@
+package enum
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Catalog holds localized display names for enum symbols keyed by (enum type, symbol, locale).
+// An enum type is identified by its name as accepted by LookupType (like
+// "example.com/app/storage.Tier" or, if only one registered type has it, "storage.Tier") and a
+// symbol by its method name so that catalogs can be loaded from files. A Catalog is safe for
+// concurrent use.
+type Catalog struct {
+	mu         sync.RWMutex
+	names      map[catalogKey]string // (enum type, symbol, locale) -> display name
+	separators map[string]string     // Locale -> separator used to join flag symbols
+}
+
+// catalogKey is an internal type identifying a symbol's display name for a locale.
+type catalogKey struct {
+	enumType, symbol, locale string
+}
+
+// NewCatalog returns an empty Catalog.
+func NewCatalog() *Catalog {
+	return &Catalog{names: map[catalogKey]string{}, separators: map[string]string{}}
+}
+
+// DefaultCatalog is the Catalog used by the Display, DisplayFlags, ParseDisplay and
+// ParseDisplayFlags functions.
+var DefaultCatalog = NewCatalog()
+
+// Add adds display names (symbol method name -> display name) for an enum type's symbols in
+// a locale (like "fr" or "fr-CA").
+func (c *Catalog) Add(enumType reflect.Type, locale string, displayNames map[string]string) {
+	c.add(qualifiedName(enumType), locale, displayNames)
+}
+
+// add is an internal method that adds display names for the enum type named typeName.
+func (c *Catalog) add(typeName string, locale string, displayNames map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for symbol, displayName := range displayNames {
+		c.names[catalogKey{typeName, symbol, locale}] = displayName
+	}
+}
+
+// SetSeparator sets the string used to join flag symbols for a locale (the default is ", ").
+func (c *Catalog) SetSeparator(locale string, sep string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.separators[locale] = sep
+}
+
+// catalogJSON is the JSON representation of a Catalog. For example:
+//
+//	{
+//	   "separators": {"fr": " et "},
+//	   "types": {"storage.Tier": {"fr": {"Hot": "Chaud", "Cool": "Froid"}}}
+//	}
+type catalogJSON struct {
+	Separators map[string]string                       `json:"separators"` // Locale -> separator
+	Types      map[string]map[string]map[string]string `json:"types"`      // Enum type -> locale -> symbol -> display name
+}
+
+// LoadJSON adds the separators & display names read from r (in the format shown by catalogJSON) to c.
+func (c *Catalog) LoadJSON(r io.Reader) error {
+	cj := catalogJSON{}
+	if err := json.NewDecoder(r).Decode(&cj); err != nil {
+		return fmt.Errorf("couldn't load catalog: %w", err)
+	}
+	for locale, sep := range cj.Separators {
+		c.SetSeparator(locale, sep)
+	}
+	for typeName, locales := range cj.Types {
+		for locale, displayNames := range locales {
+			c.add(typeName, locale, displayNames)
+		}
+	}
+	return nil
+}
+
+// LoadPO adds the display names for a locale read from r (a gettext PO file) to c. Each entry's
+// msgctxt is the enum type, its msgid is the symbol method name & its msgstr is the display name:
+//
+//	msgctxt "storage.Tier"
+//	msgid "Hot"
+//	msgstr "Chaud"
+func (c *Catalog) LoadPO(r io.Reader, locale string) error {
+	entry := map[string]string{}
+	field := "" // The keyword whose (possibly multi-line) string is being read
+	flush := func() {
+		if entry["msgctxt"] != "" && entry["msgid"] != "" && entry["msgstr"] != "" {
+			c.add(entry["msgctxt"], locale, map[string]string{entry["msgid"]: entry["msgstr"]})
+		}
+		entry, field = map[string]string{}, ""
+	}
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			flush() // A blank line ends an entry
+			continue
+		case strings.HasPrefix(line, "#"):
+			continue // Skip comments
+		case !strings.HasPrefix(line, `"`):
+			keyword, rest, _ := strings.Cut(line, " ")
+			if entry[keyword] != "" || (keyword == "msgctxt" && entry["msgid"] != "") {
+				flush() // A new entry started without a blank line
+			}
+			field, line = keyword, strings.TrimSpace(rest)
+		}
+		s, err := strconv.Unquote(line)
+		if err != nil || field == "" {
+			return fmt.Errorf("couldn't load catalog: line %d: malformed string %s", lineNum, line)
+		}
+		entry[field] += s // A string on its own line continues the previous keyword's string
+	}
+	flush()
+	return scanner.Err()
+}
+
+// catalogNames is an internal function that returns the names identifying an enum type in a
+// Catalog: its package path-qualified name and, if LookupType finds the type by it, its
+// package name-qualified name.
+func catalogNames(enumType reflect.Type) []string {
+	typeNames := []string{qualifiedName(enumType)}
+	if t, ok := LookupType(enumType.String()); ok && t == enumType {
+		typeNames = append(typeNames, enumType.String()) // The shorter name isn't ambiguous
+	}
+	return typeNames
+}
+
+// lookup is an internal method that returns a symbol's display name for a locale from the
+// entries of the enum type with any of typeNames. If the locale (like "fr-CA") has no display
+// name, its parent locales (like "fr") are tried.
+func (c *Catalog) lookup(symbol string, locale string, typeNames ...string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for {
+		for _, typeName := range typeNames {
+			if displayName, ok := c.names[catalogKey{typeName, symbol, locale}]; ok {
+				return displayName, true
+			}
+		}
+		i := strings.LastIndexAny(locale, "-_")
+		if i < 0 {
+			return "", false
+		}
+		locale = locale[:i] // Fall back to the parent locale
+	}
+}
+
+// separator is an internal method that returns the string used to join flag symbols for a locale.
+func (c *Catalog) separator(locale string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for {
+		if sep, ok := c.separators[locale]; ok {
+			return sep
+		}
+		i := strings.LastIndexAny(locale, "-_")
+		if i < 0 {
+			return ", "
+		}
+		locale = locale[:i] // Fall back to the parent locale
+	}
+}
+
+// displayName is an internal method that returns a symbol's display name for a locale; if the
+// catalog has none, the symbol's name as returned by String is used.
+func (c *Catalog) displayName(enumType reflect.Type, symbol string, locale string) string {
+	if displayName, ok := c.lookup(symbol, locale, catalogNames(enumType)...); ok {
+		return displayName
+	}
+	return describe(enumType).name(namingOf(enumType), symbol)
+}
+
+// Display returns the display name for an enum value in a locale. If the value has no symbol,
+// its underlying value is returned as a string.
+func (c *Catalog) Display(enumValue interface{}, locale string) string {
+	enumType := reflect.TypeOf(enumValue)
+	displayName, found := "", false
+	GetSymbols(enumType, func(symbol string, value interface{}) bool {
+		if value == enumValue {
+			displayName, found = c.displayName(enumType, symbol, locale), true
+			_, deprecated := describe(enumType).deprecated[symbol]
+			return !deprecated // Keep looking for a current symbol with the same value
+		}
+		return false
+	})
+	if !found {
+		return underlyingString(reflect.ValueOf(enumValue))
+	}
+	return displayName
+}
+
+// DisplayFlags is like StringUintFlags but returns the display names (in a locale) of the
+// symbols joined by the locale's separator.
+func (c *Catalog) DisplayFlags(intValue uint64, enumType reflect.Type, locale string) string {
+	return FlagFormat{Separator: c.separator(locale)}.format(intValue, enumType,
+		func(symbol string) string { return c.displayName(enumType, symbol, locale) })
+}
+
+// ParseDisplay converts a display name in a locale (matched using Unicode case folding) to its
+// enum value. If s is not a display name, it is parsed like Parse (case-insensitively).
+func (c *Catalog) ParseDisplay(enumTypePtr reflect.Type, s string, locale string) (interface{}, error) {
+	enumType := enumTypePtr.Elem() // Convert from *T to T
+	var enumVal interface{}
+	typeNames := catalogNames(enumType)
+	GetSymbols(enumType, func(symbol string, value interface{}) bool {
+		if displayName, ok := c.lookup(symbol, locale, typeNames...); ok && strings.EqualFold(displayName, s) {
+			enumVal = value
+			return true // Stop
+		}
+		return false
+	})
+	if enumVal != nil {
+		return enumVal, nil
+	}
+	return Parse(enumTypePtr, s, true)
+}
+
+// ParseDisplayFlags is like ParseUintFlags but accepts display names (in a locale) joined by
+// the locale's separator.
+func (c *Catalog) ParseDisplayFlags(enumTypePtr reflect.Type, s string, locale string) (uint64, error) {
+	return FlagFormat{Separator: c.separator(locale)}.parse(enumTypePtr, s, true, func(symbol string) (interface{}, error) {
+		return c.ParseDisplay(enumTypePtr, symbol, locale)
+	})
+}
+
+// Display returns the display name for an enum value in a locale using DefaultCatalog.
+func Display(enumValue interface{}, locale string) string {
+	return DefaultCatalog.Display(enumValue, locale)
+}
+
+// DisplayFlags returns the display names of a flags value's symbols in a locale using DefaultCatalog.
+func DisplayFlags(intValue uint64, enumType reflect.Type, locale string) string {
+	return DefaultCatalog.DisplayFlags(intValue, enumType, locale)
+}
+
+// ParseDisplay converts a display name in a locale to its enum value using DefaultCatalog.
+func ParseDisplay(enumTypePtr reflect.Type, s string, locale string) (interface{}, error) {
+	return DefaultCatalog.ParseDisplay(enumTypePtr, s, locale)
+}
+
+// ParseDisplayFlags converts display names in a locale to a flags value using DefaultCatalog.
+func ParseDisplayFlags(enumTypePtr reflect.Type, s string, locale string) (uint64, error) {
+	return DefaultCatalog.ParseDisplayFlags(enumTypePtr, s, locale)
+}
+
+// underlyingString is an internal function that returns an enum value's underlying value
+// (integer or string) as a string without calling the enum type's String method.
+func underlyingString(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.String:
+		return v.String()
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,71 @@
+package enum_test
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleCatalog() {
+	catalog := enum.NewCatalog()
+	err := catalog.LoadJSON(strings.NewReader(`{
+		"separators": {"fr": " et "},
+		"types": {
+			"enum_test.Color":  {"fr": {"Red": "Rouge", "Green": "Vert", "Blue": "Bleu"}, "fr-CA": {"Blue": "Bleuet"}},
+			"enum_test.Access": {"fr": {"Read": "Lecture", "Write": "Écriture", "Execute": "Exécuter le projet"}}
+		}}`))
+	if err != nil {
+		printf("Error: %s\n", err)
+	}
+
+	printf("%s, %s, %s\n", catalog.Display(EColor.Blue(), "fr"), catalog.Display(EColor.Blue(), "fr-CA"), catalog.Display(EColor.Blue(), "de"))
+	printf("%s\n", catalog.Display(Color(123), "fr")) // A value with no matching symbol
+	all := catalog.DisplayFlags(uint64(EAccess.Read()|EAccess.Write()|EAccess.Execute()), reflect.TypeOf(EAccess), "fr")
+	printf("%s\n", all)
+
+	if v, err := catalog.ParseDisplay(reflect.TypeOf(&EColor), "VERT", "fr-CA"); err == nil { // Case-insensitive & falls back to "fr"
+		printf("Parsed: %s\n", v)
+	}
+	if v, err := catalog.ParseDisplayFlags(reflect.TypeOf(&EAccess), "écriture et lecture", "fr"); err == nil {
+		printf("Parsed: %s\n", Access(v))
+	}
+	if v, err := catalog.ParseDisplayFlags(reflect.TypeOf(&EAccess), all, "fr"); err == nil { // "projet" contains "et"
+		printf("Parsed: %s\n", Access(v))
+	}
+
+	// Output:
+	// Bleu, Bleuet, Blue
+	// 123
+	// Exécuter le projet et Lecture et Écriture
+	// Parsed: Green
+	// Parsed: Read, Write
+	// Parsed: Execute, Read, Write
+}
+
+func ExampleCatalog_LoadPO() {
+	catalog := enum.NewCatalog()
+	err := catalog.LoadPO(strings.NewReader(`
+# Color display names
+msgctxt "enum_test.Color"
+msgid "Red"
+msgstr "Rot"
+
+msgctxt "enum_test.Color"
+msgid "Green"
+msgstr ""
+"Gr"
+"ün"
+
+msgctxt "github.com/JeffreyRichter/enum/enum_test.Color"
+msgid "Blue"
+msgstr "Blau"
+`), "de")
+	if err != nil {
+		printf("Error: %s\n", err)
+	}
+	printf("%s %s %s\n", catalog.Display(EColor.Red(), "de"), catalog.Display(EColor.Green(), "de-AT"), catalog.Display(EColor.Blue(), "de"))
+
+	// Output:
+	// Rot Grün Blau
+}
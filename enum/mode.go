@@ -0,0 +1,120 @@
+package enum
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+const EMode = Mode(0) // Helper constant used by consuming code like EMode.Closed() (a constant so it can't be reassigned)
+
+// Mode determines how an enum type treats values & symbols it doesn't declare.
+type Mode uint8
+
+// Define Mode's "symbols" and their values:
+func (Mode) Default() Mode { return Mode(0) } // Parse/ParseInt/ParseUintFlags behave as requested by their caller
+func (Mode) Open() Mode    { return Mode(1) } // Undeclared values & symbols are preserved so they round-trip
+func (Mode) Closed() Mode  { return Mode(2) } // Parsing is strict & MarshalText rejects undeclared values
+
+// String converts a Mode enum value to its equivalent "symbol"
+func (m Mode) String() string { return StringInt(m, reflect.TypeOf(m)) }
+
+// ModePolicy is implemented by an enum type that declares its Mode. For example:
+//
+//	func (Color) EnumMode() enum.Mode { return enum.EMode.Closed() }
+type ModePolicy interface {
+	EnumMode() Mode
+}
+
+// IsKnown returns true if an enum value matches one of its type's symbols.
+func IsKnown(enumValue interface{}) bool {
+	known := false
+	GetSymbols(reflect.TypeOf(enumValue), func(enumSymbolName string, enumSymbolValue interface{}) bool {
+		known = enumSymbolValue == enumValue
+		return known // Stop when found
+	})
+	return known
+}
+
+// MarshalText returns an enum value's symbol. If the value has no symbol, its underlying value
+// (integer or string) is returned unless the enum type is Closed, in which case an error is
+// returned. Enum types can implement encoding.TextMarshaler by calling this function:
+//
+//	func (c Color) MarshalText() ([]byte, error) { return enum.MarshalText(c) }
+func MarshalText(enumValue interface{}) ([]byte, error) {
+	enumType := reflect.TypeOf(enumValue)
+	if symbol := String(enumValue, enumType); symbol != "" {
+		return []byte(symbol), nil
+	}
+	if describe(enumType).mode == EMode.Closed() {
+		return nil, fmt.Errorf("%s is not a declared %q value", underlyingString(reflect.ValueOf(enumValue)), enumType.Name())
+	}
+	return []byte(underlyingString(reflect.ValueOf(enumValue))), nil
+}
+
+// UnmarshalText sets the enum value pointed to by enumValuePtr to the value of the
+// (case-insensitive) symbol or integer string in text. Enum types can implement
+// encoding.TextUnmarshaler by calling this function:
+//
+//	func (c *Color) UnmarshalText(text []byte) error { return enum.UnmarshalText(c, text) }
+func UnmarshalText(enumValuePtr interface{}, text []byte) error {
+	ptr := reflect.ValueOf(enumValuePtr)
+	var enumVal interface{}
+	var err error
+	if ptr.Elem().Kind() == reflect.String {
+		enumVal, err = Parse(ptr.Type(), string(text), true)
+	} else {
+		enumVal, err = ParseInt(ptr.Type(), string(text), true, false)
+	}
+	if err == nil {
+		ptr.Elem().Set(reflect.ValueOf(enumVal))
+	}
+	return err
+}
+
+// OpenValue holds an enum value or, if the text it was unmarshaled from is neither a symbol nor
+// a value of T, that text verbatim so that it can be marshaled back unchanged. Use it to receive
+// values from peers that may declare symbols this program doesn't know about.
+type OpenValue[T comparable] struct {
+	Value   T      // The enum value (ignored if Unknown is not "")
+	Unknown string // The verbatim text of an unknown symbol
+}
+
+// IsKnown returns true if o holds a value matching one of T's symbols.
+func (o OpenValue[T]) IsKnown() bool {
+	return o.Unknown == "" && IsKnown(o.Value)
+}
+
+// String returns o's unknown text or its value's symbol (or underlying value).
+func (o OpenValue[T]) String() string {
+	text, _ := o.MarshalText()
+	return string(text)
+}
+
+// MarshalText returns o's unknown text verbatim or its value marshaled by T's MarshalText
+// method (if it has one) or by the MarshalText function.
+func (o OpenValue[T]) MarshalText() ([]byte, error) {
+	if o.Unknown != "" {
+		return []byte(o.Unknown), nil
+	}
+	if m, ok := interface{}(o.Value).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	return MarshalText(o.Value)
+}
+
+// UnmarshalText sets o's value by calling T's UnmarshalText method (if it has one) or the
+// UnmarshalText function. If that fails, text is preserved in o's Unknown field; no error is returned.
+func (o *OpenValue[T]) UnmarshalText(text []byte) error {
+	*o = OpenValue[T]{}
+	var err error
+	if u, ok := interface{}(&o.Value).(encoding.TextUnmarshaler); ok {
+		err = u.UnmarshalText(text)
+	} else {
+		err = UnmarshalText(&o.Value, text)
+	}
+	if err != nil {
+		*o = OpenValue[T]{Unknown: string(text)}
+	}
+	return nil
+}
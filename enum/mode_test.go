@@ -0,0 +1,74 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var ESize = Size(0).Small() // Helper variable used by consuming code (improves cross-package consumption)
+type Size uint8             // Size is Closed: undeclared values are rejected
+
+// Define Size's "symbols" and their values:
+func (Size) Small() Size { return Size(0) }
+func (Size) Large() Size { return Size(1) }
+
+func (Size) EnumMode() enum.Mode                { return enum.EMode.Closed() }
+func (s Size) MarshalText() ([]byte, error)     { return enum.MarshalText(s) }
+func (s *Size) UnmarshalText(text []byte) error { return enum.UnmarshalText(s, text) }
+
+var ERegion = Region("").USEast() // Helper variable used by consuming code (improves cross-package consumption)
+type Region string                // Region is Open: newer peers may send regions this program doesn't know
+
+// Define Region's "symbols" and their values:
+func (Region) USEast() Region { return Region("us-east") }
+func (Region) EUWest() Region { return Region("eu-west") }
+
+func (Region) EnumMode() enum.Mode                { return enum.EMode.Open() }
+func (r Region) MarshalText() ([]byte, error)     { return enum.MarshalText(r) }
+func (r *Region) UnmarshalText(text []byte) error { return enum.UnmarshalText(r, text) }
+
+func ExampleModePolicy() {
+	// Closed: integer strings aren't accepted & undeclared values can't be marshaled
+	if _, err := enum.ParseInt(reflect.TypeOf(&ESize), "7", true, false); err != nil {
+		printf("Parse error: %s\n", err)
+	}
+	if _, err := enum.MarshalText(Size(7)); err != nil {
+		printf("Marshal error: %s\n", err)
+	}
+
+	// Open: unknown symbols round-trip verbatim
+	var regions []Region
+	if err := json.Unmarshal([]byte(`["USEast", "ap-south"]`), &regions); err == nil {
+		for _, r := range regions {
+			printf("%s known=%t\n", string(r), enum.IsKnown(r))
+		}
+		b, _ := json.Marshal(regions)
+		printf("%s\n", b)
+	}
+
+	// Output:
+	// Parse error: couldn't parse "7" into a "Size"
+	// Marshal error: 7 is not a declared "Size" value
+	// us-east known=true
+	// ap-south known=false
+	// ["USEast","ap-south"]
+}
+
+func ExampleOpenValue() {
+	var sizes []enum.OpenValue[Size] // Preserves symbols added by newer peers
+	if err := json.Unmarshal([]byte(`["large", "Huge", "small"]`), &sizes); err == nil {
+		for _, s := range sizes {
+			printf("%s known=%t\n", s, s.IsKnown())
+		}
+		b, _ := json.Marshal(sizes)
+		printf("%s\n", b)
+	}
+
+	// Output:
+	// Large known=true
+	// Huge known=false
+	// Small known=true
+	// ["Large","Huge","Small"]
+}
@@ -0,0 +1,81 @@
+package enum
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// Naming converts a symbol's Go method name (like LightBlue) into the name used for the symbol
+// in strings (like "light_blue"). A nil Naming uses the method name unchanged.
+type Naming func(symbolName string) string
+
+// NamingPolicy is implemented by an enum type that wants its symbols formatted & parsed using
+// a Naming instead of its Go method names. For example:
+//
+//	func (Color) EnumNaming() enum.Naming { return enum.SnakeCase }
+type NamingPolicy interface {
+	EnumNaming() Naming
+}
+
+// The predefined naming policies. For a symbol method named LightBlue they produce:
+var (
+	SnakeCase          Naming = func(s string) string { return joinWords(s, "_", strings.ToLower) } // light_blue
+	KebabCase          Naming = func(s string) string { return joinWords(s, "-", strings.ToLower) } // light-blue
+	ScreamingSnakeCase Naming = func(s string) string { return joinWords(s, "_", strings.ToUpper) } // LIGHT_BLUE
+	LowerCase          Naming = strings.ToLower                                                     // lightblue
+)
+
+// namingOf is an internal function that returns the Naming declared by an enum type (nil if none).
+func namingOf(enumType reflect.Type) Naming {
+	if enumType.Kind() == reflect.Ptr {
+		enumType = enumType.Elem() // Parse functions are passed *T; the policy is declared on T
+	}
+	if np, ok := reflect.Zero(enumType).Interface().(NamingPolicy); ok {
+		return np.EnumNaming()
+	}
+	return nil
+}
+
+// name is an internal method that applies n to a symbol's Go method name.
+func (n Naming) name(symbolName string) string {
+	if n == nil {
+		return symbolName // No naming policy, use the method name as is
+	}
+	return n(symbolName)
+}
+
+// matches is an internal method that returns true if s is a symbol's Go method name or its
+// name under n.
+func (n Naming) matches(symbolName string, s string, caseInsensitive bool) bool {
+	equal := func(a, b string) bool { return a == b }
+	if caseInsensitive {
+		equal = strings.EqualFold
+	}
+	return equal(symbolName, s) || (n != nil && equal(n(symbolName), s))
+}
+
+// joinWords is an internal function that splits a Go identifier into its words (LightBlue,
+// HTTPServer & Tier2 become [Light Blue], [HTTP Server] & [Tier2]), converts each word's case
+// and joins them with sep.
+func joinWords(identifier string, sep string, convertCase func(string) string) string {
+	runes := []rune(identifier)
+	sb := strings.Builder{}
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			// A word starts at an upper-case letter following a lower-case letter or digit (lightBlue)
+			// or at the last upper-case letter of an acronym followed by a lower-case letter (HTTPServer)
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				sb.WriteString(sep)
+			}
+		}
+		if r == '_' {
+			sb.WriteString(sep) // Treat existing underscores as word separators
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return convertCase(sb.String())
+}
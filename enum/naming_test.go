@@ -0,0 +1,73 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var EShade = Shade(0).None() // Helper variable used by consuming code (improves cross-package consumption)
+type Shade uint8             // Shade symbols are written as snake_case on the wire
+
+// Define Shade's "symbols" and their values:
+func (Shade) None() Shade      { return Shade(0) }
+func (Shade) LightBlue() Shade { return Shade(1) }
+func (Shade) DarkGreen() Shade { return Shade(2) }
+
+// EnumNaming makes String/Parse use snake_case symbols (this method is not a symbol)
+func (Shade) EnumNaming() enum.Naming { return enum.SnakeCase }
+
+// String converts a Shade enum value to its equivalent snake_case "symbol"
+func (s Shade) String() string {
+	return enum.StringInt(s, reflect.TypeOf(s))
+}
+
+// Parse sets s if str matches a symbol (snake_case or Go name) or is a number which can be parsed.
+func (s *Shade) Parse(str string) error {
+	enumVal, err := enum.ParseInt(reflect.TypeOf(s), str, true, false)
+	if enumVal != nil {
+		*s = enumVal.(Shade)
+	}
+	return err
+}
+
+func ExampleNamingPolicy() {
+	s := EShade.LightBlue()
+	printf("Shade: %s\n", s) // Calls String()
+
+	for _, str := range []string{"dark_green", "LightBlue", "DARK_GREEN", "2", "purple"} {
+		if err := s.Parse(str); err == nil {
+			printf("Parsed %q: %s\n", str, s)
+		} else {
+			printf("Parse error: %s\n", err)
+		}
+	}
+
+	// A naming policy can also be used for a single call, overriding the type's policy
+	printf("%s\n", enum.KebabCase.String(EShade.DarkGreen(), reflect.TypeOf(EShade)))
+	printf("%s\n", enum.ScreamingSnakeCase.String(EShade.DarkGreen(), reflect.TypeOf(EShade)))
+	printf("%s\n", enum.Naming(nil).String(EShade.DarkGreen(), reflect.TypeOf(EShade)))
+
+	// Output:
+	// Shade: light_blue
+	// Parsed "dark_green": dark_green
+	// Parsed "LightBlue": light_blue
+	// Parsed "DARK_GREEN": dark_green
+	// Parsed "2": dark_green
+	// Parse error: couldn't parse "purple" into a "Shade"
+	// dark-green
+	// DARK_GREEN
+	// DarkGreen
+}
+
+func ExampleSnakeCase() {
+	for _, name := range []string{"LightBlue", "HTTPServer", "Tier2Storage", "TCP"} {
+		printf("%-12s %-13s %-13s %s\n", name, enum.SnakeCase(name), enum.KebabCase(name), enum.ScreamingSnakeCase(name))
+	}
+
+	// Output:
+	// LightBlue    light_blue    light-blue    LIGHT_BLUE
+	// HTTPServer   http_server   http-server   HTTP_SERVER
+	// Tier2Storage tier2_storage tier2-storage TIER2_STORAGE
+	// TCP          tcp           tcp           TCP
+}
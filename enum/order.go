@@ -0,0 +1,79 @@
+package enum
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+)
+
+// SymbolOrder is implemented by an enum type whose symbols' order (used by Compare, Index, Next,
+// EnumSet, etc.) is not the order of their values. EnumOrder returns the symbol method names from
+// lowest to highest; symbols it omits follow them (sorted by value). For example:
+//
+//	func (Level) EnumOrder() []string { return []string{"Debug", "Info", "Warn", "Error"} }
+type SymbolOrder interface {
+	EnumOrder() []string
+}
+
+// Compare returns -1 if a precedes b in T's symbol order, 1 if a follows b & 0 if they're equal.
+// T's symbol order is declared by its EnumOrder method; otherwise, values are compared by their
+// underlying values (or by their ordinals if their kind, like bool, has no order). Values without
+// a symbol precede all symbols unless they're compared by their underlying values.
+func Compare[T comparable](a T, b T) int {
+	d := describe(reflect.TypeOf(a))
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	ia, aOK := d.ordinals[a]
+	ib, bOK := d.ordinals[b]
+	switch {
+	case a == b:
+		return 0
+	case !d.declared && lessValue(va, vb):
+		return -1
+	case !d.declared && lessValue(vb, va):
+		return 1
+	case aOK && bOK:
+		return cmp.Compare(ia, ib)
+	case aOK != bOK:
+		if aOK {
+			return 1 // b has no symbol so it precedes a
+		}
+		return -1
+	case lessValue(va, vb): // Neither value has a symbol
+		return -1
+	case lessValue(vb, va):
+		return 1
+	default:
+		return 0 // Neither value has a symbol & their kind has no order
+	}
+}
+
+// Less returns true if a precedes b in T's symbol order (see Compare).
+func Less[T comparable](a T, b T) bool { return Compare(a, b) < 0 }
+
+// AtLeast returns true if v is threshold or follows it in T's symbol order (like a log level
+// that is at least Warn).
+func AtLeast[T comparable](v T, threshold T) bool { return Compare(v, threshold) >= 0 }
+
+// AtMost returns true if v is threshold or precedes it in T's symbol order.
+func AtMost[T comparable](v T, threshold T) bool { return Compare(v, threshold) <= 0 }
+
+// Max returns the value that is last in T's symbol order (T's zero value if there are no values).
+func Max[T comparable](values ...T) T {
+	if len(values) == 0 {
+		return *new(T)
+	}
+	return slices.MaxFunc(values, Compare[T])
+}
+
+// Min returns the value that is first in T's symbol order (T's zero value if there are no values).
+func Min[T comparable](values ...T) T {
+	if len(values) == 0 {
+		return *new(T)
+	}
+	return slices.MinFunc(values, Compare[T])
+}
+
+// Sort sorts values into T's symbol order (keeping equal values in their original order).
+func Sort[T comparable](values []T) {
+	slices.SortStableFunc(values, Compare[T])
+}
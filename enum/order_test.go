@@ -0,0 +1,57 @@
+package enum_test
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var ESeverity = Severity(0).Info() // Helper variable used by consuming code (improves cross-package consumption)
+type Severity int                  // Severity's Debug symbol was added after the others so its value doesn't reflect its order
+
+// Define Severity's "symbols" and their values:
+func (Severity) Info() Severity  { return Severity(0) }
+func (Severity) Warn() Severity  { return Severity(1) }
+func (Severity) Error() Severity { return Severity(2) }
+func (Severity) Debug() Severity { return Severity(3) }
+
+// EnumOrder lists Severity's symbols from lowest to highest
+func (Severity) EnumOrder() []string { return []string{"Debug", "Info", "Warn", "Error"} }
+
+// String converts a Severity enum value to its equivalent "symbol"
+func (s Severity) String() string { return enum.StringInt(s, reflect.TypeOf(s)) }
+
+func ExampleCompare() {
+	printf("%t %t\n", enum.Less(ESeverity.Debug(), ESeverity.Info()), enum.AtLeast(ESeverity.Debug(), ESeverity.Warn()))
+	printf("%t\n", enum.AtMost(ESeverity.Warn(), ESeverity.Error()))
+
+	seen := []Severity{ESeverity.Error(), ESeverity.Debug(), ESeverity.Warn(), ESeverity.Info()}
+	printf("%s %s\n", enum.Max(seen...), enum.Min(seen...))
+	enum.Sort(seen)
+	printf("%v\n", seen)
+	printf("%s\n", enum.Ordinals[Severity]{}.Next(ESeverity.Debug(), enum.EBound.Clamp()))
+	printf("%v %v\n", slices.Collect(enum.OrdinalValues[Severity]()), slices.Collect(enum.SortedValues[Severity]()))
+
+	// Output:
+	// true false
+	// true
+	// Error Debug
+	// [Debug Info Warn Error]
+	// Info
+	// [Debug Info Warn Error] [Info Warn Error Debug]
+}
+
+var EToggle = Toggle(false).Off() // Helper variable used by consuming code (improves cross-package consumption)
+type Toggle bool                  // Toggle's underlying kind (bool) has no order
+
+// Define Toggle's "symbols" and their values:
+func (Toggle) Off() Toggle { return Toggle(false) }
+func (Toggle) On() Toggle  { return Toggle(true) }
+
+func ExampleCompare_unordered() {
+	printf("%d %d %d\n", enum.Compare(EToggle.Off(), EToggle.On()), enum.Compare(EToggle.On(), EToggle.Off()), enum.Compare(EToggle.On(), EToggle.On()))
+
+	// Output:
+	// -1 1 0
+}
@@ -0,0 +1,151 @@
+package enum
+
+import (
+	"reflect"
+	"sort"
+)
+
+const EBound = Bound(0) // Helper constant used by consuming code like EBound.Wrap() (a constant so it can't be reassigned)
+
+// Bound determines what Next & Prev return when stepping past the last or first symbol.
+type Bound uint8
+
+// Define Bound's "symbols" and their values:
+func (Bound) Clamp() Bound { return Bound(0) } // Stay at the last (or first) symbol
+func (Bound) Wrap() Bound  { return Bound(1) } // Cycle around to the first (or last) symbol
+
+// String converts a Bound enum value to its equivalent "symbol"
+func (b Bound) String() string { return StringInt(b, reflect.TypeOf(b)) }
+
+// Index returns the ordinal (0, 1, 2, ...) of an enum value's symbol in symbol order (as declared
+// by the enum type's EnumOrder method or else sorted by value) or -1 if the value has no symbol.
+func Index(enumValue interface{}) int {
+	if i, ok := describe(reflect.TypeOf(enumValue)).ordinals[enumValue]; ok {
+		return i
+	}
+	return -1
+}
+
+// FromIndex returns the value of the enum type's symbol whose ordinal is index and true, or
+// nil and false if index is out of range.
+func FromIndex(enumType reflect.Type, index int) (interface{}, bool) {
+	d := describe(enumType)
+	if index < 0 || index >= len(d.ordered) {
+		return nil, false
+	}
+	return d.ordered[index].value, true
+}
+
+// Count returns the number of distinct values declared by the enum type's symbols.
+func Count(enumType reflect.Type) int {
+	return len(describe(enumType).ordered)
+}
+
+// First returns the value of the enum type's first symbol (nil if it has no symbols).
+func First(enumType reflect.Type) interface{} {
+	v, _ := FromIndex(enumType, 0)
+	return v
+}
+
+// Last returns the value of the enum type's last symbol (nil if it has no symbols).
+func Last(enumType reflect.Type) interface{} {
+	v, _ := FromIndex(enumType, Count(enumType)-1)
+	return v
+}
+
+// Next returns the value of the symbol after an enum value's symbol; b determines what is
+// returned after the last symbol. If the value has no symbol, the first symbol whose value is
+// greater is returned (or the first symbol if the enum type has an EnumOrder method). If the enum
+// type has no symbols, the value is returned.
+func Next(enumValue interface{}, b Bound) interface{} {
+	return describe(reflect.TypeOf(enumValue)).step(enumValue, 1, b)
+}
+
+// Prev returns the value of the symbol before an enum value's symbol; b determines what is
+// returned before the first symbol. If the value has no symbol, the last symbol whose value is
+// less is returned (or the value is treated as preceding the first symbol if the enum type has an
+// EnumOrder method). If the enum type has no symbols, the value is returned.
+func Prev(enumValue interface{}, b Bound) interface{} {
+	return describe(reflect.TypeOf(enumValue)).step(enumValue, -1, b)
+}
+
+// Cycle returns the value of the symbol after an enum value's symbol, wrapping around to the
+// first symbol after the last (like Next with EBound.Wrap()).
+func Cycle(enumValue interface{}) interface{} { return Next(enumValue, EBound.Wrap()) }
+
+// step is an internal method that returns the value of the symbol delta (1 or -1) ordinals
+// from an enum value's symbol.
+func (d *descriptor) step(enumValue interface{}, delta int, b Bound) interface{} {
+	n := len(d.ordered)
+	if n == 0 {
+		return enumValue
+	}
+	i, ok := d.ordinals[enumValue]
+	switch {
+	case ok:
+	case d.declared: // Values without a symbol precede all symbols (as Compare orders them)
+		i = -1 // Stepping forward lands on the first symbol
+		if delta < 0 {
+			i = 0 // Stepping backward goes before the first symbol
+		}
+	default: // Step from where the value would be if it had a symbol
+		i = sort.Search(n, func(j int) bool {
+			return lessValue(reflect.ValueOf(enumValue), reflect.ValueOf(d.ordered[j].value))
+		})
+		if delta > 0 {
+			i-- // Stepping forward from just before the first greater symbol lands on it
+		}
+	}
+	switch i += delta; {
+	case i >= 0 && i < n:
+	case b == EBound.Wrap():
+		i = (i%n + n) % n
+	case i < 0:
+		i = 0
+	default:
+		i = n - 1
+	}
+	return d.ordered[i].value
+}
+
+// Ordinals is a handle offering the ordinal functions (Index, Next, etc.) for enum type T
+// without reflection or type assertions. For example:
+//
+//	next := enum.Ordinals[Color]{}.Next(EColor.Green(), enum.EBound.Wrap())
+type Ordinals[T comparable] struct{}
+
+// Index returns the ordinal of v's symbol or -1 if v has no symbol.
+func (Ordinals[T]) Index(v T) int { return Index(v) }
+
+// FromIndex returns the value of T's symbol whose ordinal is index and true, or T's zero value
+// and false if index is out of range.
+func (Ordinals[T]) FromIndex(index int) (T, bool) {
+	if v, ok := FromIndex(reflect.TypeOf(*new(T)), index); ok {
+		return v.(T), true
+	}
+	return *new(T), false
+}
+
+// Count returns the number of distinct values declared by T's symbols.
+func (Ordinals[T]) Count() int { return Count(reflect.TypeOf(*new(T))) }
+
+// First returns the value of T's first symbol (T's zero value if it has no symbols).
+func (o Ordinals[T]) First() T {
+	v, _ := o.FromIndex(0)
+	return v
+}
+
+// Last returns the value of T's last symbol (T's zero value if it has no symbols).
+func (o Ordinals[T]) Last() T {
+	v, _ := o.FromIndex(o.Count() - 1)
+	return v
+}
+
+// Next returns the value of the symbol after v's symbol as described by the Next function.
+func (Ordinals[T]) Next(v T, b Bound) T { return Next(v, b).(T) }
+
+// Prev returns the value of the symbol before v's symbol as described by the Prev function.
+func (Ordinals[T]) Prev(v T, b Bound) T { return Prev(v, b).(T) }
+
+// Cycle returns the value of the symbol after v's symbol, wrapping around after the last symbol.
+func (Ordinals[T]) Cycle(v T) T { return Cycle(v).(T) }
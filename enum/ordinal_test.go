@@ -0,0 +1,34 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleOrdinals() {
+	colors := enum.Ordinals[Color]{}
+	printf("%d of %d\n", colors.Index(EColor.Green()), colors.Count())
+	printf("%s %s\n", colors.First(), colors.Last())
+	printf("%s\n", colors.Next(EColor.Green(), enum.EBound.Clamp()))
+	printf("%s %s\n", colors.Next(EColor.Blue(), enum.EBound.Clamp()), colors.Next(EColor.Blue(), enum.EBound.Wrap()))
+	printf("%s %s\n", colors.Prev(EColor.None(), enum.EBound.Clamp()), colors.Prev(EColor.None(), enum.EBound.Wrap()))
+	printf("%s\n", colors.Cycle(EColor.Blue()))
+
+	// A value with no symbol steps to the nearest symbol
+	printf("%d %s\n", colors.Index(Color(-5)), colors.Next(Color(-5), enum.EBound.Clamp()))
+
+	// The reflective functions work on interface{} values
+	v, ok := enum.FromIndex(reflect.TypeOf(EColor), 1)
+	printf("%s %t %v\n", v, ok, enum.Next(v, enum.EBound.Wrap()))
+
+	// Output:
+	// 2 of 4
+	// None Blue
+	// Blue
+	// Blue None
+	// None Blue
+	// None
+	// -1 None
+	// Red true Green
+}
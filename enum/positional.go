@@ -0,0 +1,87 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FlagChar maps a bit flags symbol to the character representing it in a positional string.
+type FlagChar struct {
+	Symbol string // The symbol method name (like "Read")
+	Char   rune   // The character shown when the symbol's bits are set (like 'r')
+}
+
+// PositionalFlags is implemented by a bit flags enum type that can be rendered as a compact
+// positional string (like "r-x" as shown by ls -l) where each position shows a symbol's
+// character if the symbol's bits are set or '-' if they're clear. For example:
+//
+//	func (Access) EnumFlagChars() []enum.FlagChar {
+//	   return []enum.FlagChar{{"Read", 'r'}, {"Write", 'w'}, {"Execute", 'x'}}
+//	}
+type PositionalFlags interface {
+	EnumFlagChars() []FlagChar
+}
+
+// positionalValues is an internal function that returns an enum type's FlagChars & the value of
+// each FlagChar's symbol.
+func positionalValues(enumType reflect.Type) ([]FlagChar, []uint64, error) {
+	pf, ok := reflect.Zero(enumType).Interface().(PositionalFlags)
+	if !ok {
+		return nil, nil, fmt.Errorf("%q doesn't implement enum.PositionalFlags", enumType.Name())
+	}
+	chars := pf.EnumFlagChars()
+	values := make([]uint64, len(chars))
+	for i, fc := range chars {
+		symbol, found := findSymbol(enumType, fc.Symbol, false, nil)
+		if !found {
+			return nil, nil, fmt.Errorf("%q has no symbol %q", enumType.Name(), fc.Symbol)
+		}
+		values[i] = flagBits(symbol.Value)
+	}
+	return chars, values, nil
+}
+
+// StringPositional returns intValue as a positional string (like "r-x") using the characters
+// declared by the enum type's EnumFlagChars method. Bits that don't belong to any of the
+// declared symbols are not shown.
+func StringPositional(intValue uint64, enumType reflect.Type) (string, error) {
+	chars, values, err := positionalValues(enumType)
+	if err != nil {
+		return "", err
+	}
+	sb := strings.Builder{}
+	for i, fc := range chars {
+		if values[i] != 0 && intValue&values[i] == values[i] {
+			sb.WriteRune(fc.Char)
+		} else {
+			sb.WriteRune('-')
+		}
+	}
+	return sb.String(), nil
+}
+
+// ParsePositional parses a positional string (like "r-x") produced by StringPositional; each
+// position must be its symbol's character or '-'.
+func ParsePositional(enumTypePtr reflect.Type, s string) (uint64, error) {
+	enumType := enumTypePtr.Elem() // Convert from *T to T
+	chars, values, err := positionalValues(enumType)
+	if err != nil {
+		return 0, err
+	}
+	runes := []rune(s)
+	if len(runes) != len(chars) {
+		return 0, fmt.Errorf("couldn't parse %q into a %q: expected %d characters", s, enumType.Name(), len(chars))
+	}
+	val := uint64(0)
+	for i, r := range runes {
+		switch r {
+		case chars[i].Char:
+			val |= values[i]
+		case '-':
+		default:
+			return 0, fmt.Errorf("couldn't parse %q into a %q: position %d must be %q or '-'", s, enumType.Name(), i+1, chars[i].Char)
+		}
+	}
+	return val, nil
+}
@@ -0,0 +1,34 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+// EnumFlagChars declares Access' positional ("rwx") rendering
+func (Access) EnumFlagChars() []enum.FlagChar {
+	return []enum.FlagChar{{Symbol: "Read", Char: 'r'}, {Symbol: "Write", Char: 'w'}, {Symbol: "Execute", Char: 'x'}}
+}
+
+func ExampleStringPositional() {
+	for _, a := range []Access{EAccess.Read() | EAccess.Execute(), EAccess.None(), EAccess.Read() | EAccess.Write() | EAccess.Execute()} {
+		s, _ := enum.StringPositional(uint64(a), reflect.TypeOf(a))
+		printf("%s %s\n", s, a)
+	}
+	for _, s := range []string{"-w-", "rw", "rwz"} {
+		if v, err := enum.ParsePositional(reflect.TypeOf(&EAccess), s); err == nil {
+			printf("%s\n", Access(v))
+		} else {
+			printf("Parse error: %s\n", err)
+		}
+	}
+
+	// Output:
+	// r-x Execute, Read
+	// --- None
+	// rwx Execute, Read, Write
+	// Write
+	// Parse error: couldn't parse "rw" into a "Access": expected 3 characters
+	// Parse error: couldn't parse "rwz" into a "Access": position 3 must be 'x' or '-'
+}
@@ -0,0 +1,24 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleRegister() {
+	enum.Register(ColorMenu()) // ColorIdiomaticEnum's symbols are constants, not methods
+
+	colorType := reflect.TypeOf(ColorRed)
+	printf("%s\n", enum.StringInt(ColorIdiomaticEnum(2), colorType))
+	v, err := enum.Parse(reflect.TypeOf((*ColorIdiomaticEnum)(nil)), "blue", true)
+	printf("%v %v\n", v == ColorBlue, err)
+	printf("%v\n", enum.NewEnumSet(ColorBlue, ColorNone))
+	printf("%v\n", enum.Ordinals[ColorIdiomaticEnum]{}.Next(ColorBlue, enum.EBound.Wrap()) == ColorNone)
+
+	// Output:
+	// Green
+	// true <nil>
+	// None, Blue
+	// true
+}
@@ -0,0 +1,137 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// registry holds the enum types that have been registered (explicitly via RegisterType or
+// implicitly when the package first describes a type) and the registered dynamic enums so they
+// can be looked up by name.
+var registry = struct {
+	sync.RWMutex
+	types   map[string]reflect.Type   // Package path-qualified name (like "example.com/app/storage.Tier") -> type
+	short   map[string][]reflect.Type // Package name-qualified name (like "storage.Tier") -> types
+	dynamic map[string]*DynamicEnum   // Name (like "tenant.Plan") -> dynamic enum
+}{types: map[string]reflect.Type{}, short: map[string][]reflect.Type{}, dynamic: map[string]*DynamicEnum{}}
+
+// RegisterType adds an enum type (T or *T) to the registry so that LookupType & ParseQualified
+// can find it by name. An enum type is also registered the first time this package uses it. This
+// package's own option types (like Mode & Bound) are never registered.
+func RegisterType(enumType reflect.Type) {
+	describe(enumType) // describe registers the type
+}
+
+// enumPkgPath is this package's import path; its own option types aren't registered.
+var enumPkgPath = reflect.TypeOf(Mode(0)).PkgPath()
+
+// register is an internal function that adds an enum type to the registry (unless it is one of
+// this package's own types).
+func register(enumType reflect.Type) {
+	if enumType.PkgPath() == enumPkgPath {
+		return // Keep this package's option types out of LookupType & RegisteredTypes
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	name := qualifiedName(enumType)
+	if _, ok := registry.types[name]; ok {
+		return // Already registered
+	}
+	registry.types[name] = enumType
+	registry.short[enumType.String()] = append(registry.short[enumType.String()], enumType)
+}
+
+// qualifiedName is an internal function that returns an enum type's package path-qualified name.
+func qualifiedName(enumType reflect.Type) string {
+	if enumType.PkgPath() == "" {
+		return enumType.String() // A predeclared or unnamed type
+	}
+	return enumType.PkgPath() + "." + enumType.Name()
+}
+
+// LookupType returns the registered enum type with a name like "storage.Tier" (as returned by
+// reflect.Type's String method) or "example.com/app/storage.Tier" (qualified by package path).
+// A name like "storage.Tier" is not found if types from several packages named storage have it.
+func LookupType(name string) (reflect.Type, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	if t, ok := registry.types[name]; ok {
+		return t, true
+	}
+	if types := registry.short[name]; len(types) == 1 {
+		return types[0], true
+	}
+	return nil, false
+}
+
+// RegisteredTypes returns the registered enum types sorted by their package path-qualified names.
+func RegisteredTypes() []reflect.Type {
+	registry.RLock()
+	defer registry.RUnlock()
+	names := make([]string, 0, len(registry.types))
+	for name := range registry.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	types := make([]reflect.Type, len(names))
+	for i, name := range names {
+		types[i] = registry.types[name]
+	}
+	return types
+}
+
+// RegisterDynamic adds a dynamic enum to the registry so that LookupDynamic & ParseQualified can
+// find it by name; it returns an error if another dynamic enum has the name.
+func RegisterDynamic(d *DynamicEnum) error {
+	registry.Lock()
+	defer registry.Unlock()
+	if existing, ok := registry.dynamic[d.name]; ok && existing != d {
+		return fmt.Errorf("a dynamic enum named %q is already registered", d.name)
+	}
+	registry.dynamic[d.name] = d
+	return nil
+}
+
+// LookupDynamic returns the registered dynamic enum with a name (like "tenant.Plan").
+func LookupDynamic(name string) (*DynamicEnum, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	d, ok := registry.dynamic[name]
+	return d, ok
+}
+
+// RegisteredDynamicEnums returns the registered dynamic enums sorted by name.
+func RegisteredDynamicEnums() []*DynamicEnum {
+	registry.RLock()
+	defer registry.RUnlock()
+	enums := make([]*DynamicEnum, 0, len(registry.dynamic))
+	for _, d := range registry.dynamic {
+		enums = append(enums, d)
+	}
+	sort.Slice(enums, func(i, j int) bool { return enums[i].name < enums[j].name })
+	return enums
+}
+
+// ParseQualified converts a symbol qualified by its registered enum type's name (like
+// "storage.Tier.Hot") to its enum value (like storage.Tier(2)) using Parse. If no enum type has
+// the name, a registered dynamic enum's symbol is converted to a DynamicValue.
+func ParseQualified(s string, caseInsensitive bool) (interface{}, error) {
+	i := strings.LastIndexByte(s, '.')
+	if i < 0 {
+		return nil, fmt.Errorf("couldn't parse %q: it isn't qualified by an enum type's name", s)
+	}
+	if enumType, ok := LookupType(s[:i]); ok {
+		return Parse(reflect.PointerTo(enumType), s[i+1:], caseInsensitive)
+	}
+	if d, ok := LookupDynamic(s[:i]); ok {
+		v, err := d.Parse(s[i+1:], caseInsensitive)
+		if err != nil {
+			return nil, err
+		}
+		return DynamicValue{d, v}, nil
+	}
+	return nil, fmt.Errorf("couldn't parse %q: %q isn't a registered enum type", s, s[:i])
+}
@@ -0,0 +1,30 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+func ExampleParseQualified() {
+	enum.RegisterType(reflect.TypeOf(ETier))
+
+	v, err := enum.ParseQualified("enum_test.Tier.Cool", false)
+	printf("%v %T %v\n", v, v, err)
+
+	t, ok := enum.LookupType("github.com/JeffreyRichter/enum/enum_test.Tier")
+	printf("%v %t\n", t, ok)
+
+	_, err = enum.ParseQualified("storage.Tier.Hot", false)
+	printf("%v\n", err)
+
+	_ = enum.EMode.Closed().String() // The package's own option types aren't registered
+	_, ok = enum.LookupType("enum.Mode")
+	printf("%t\n", ok)
+
+	// Output:
+	// cool enum_test.Tier <nil>
+	// enum_test.Tier true
+	// couldn't parse "storage.Tier.Hot": "storage.Tier" isn't a registered enum type
+	// false
+}
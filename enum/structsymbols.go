@@ -0,0 +1,87 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// frozenStructs maps an enum type's reflect.Type to a copy of the struct registered by
+// RegisterStruct for it.
+var frozenStructs sync.Map
+
+// RegisterStruct registers the symbols of an enum type whose symbols are the fields of a struct
+// value (or pointer to one) like:
+//
+//	var EColor = struct{ None, Red, Green, Blue Color }{0, 1, 2, 3}
+//	func init() { enum.RegisterStruct(EColor) }
+//
+// Each exported field is a symbol named after the field; an error is returned if the fields
+// aren't all of the same enum type. A copy of the struct is kept so that all of this package's
+// functions use the registered values even if the struct's fields are later changed; use
+// ValidateStruct to detect such changes.
+func RegisterStruct(symbols interface{}) error {
+	v, err := structValue(symbols)
+	if err != nil {
+		return err
+	}
+	var enumType reflect.Type
+	list := []Symbol{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if enumType == nil {
+			enumType = field.Type
+		}
+		if field.Type != enumType {
+			return fmt.Errorf("%s's field %q is a %s, not a %s", v.Type(), field.Name, field.Type, enumType)
+		}
+		list = append(list, Symbol{field.Name, v.Field(i).Interface()})
+	}
+	if enumType == nil {
+		return fmt.Errorf("%s has no exported fields", v.Type())
+	}
+	frozen := reflect.New(v.Type()).Elem()
+	frozen.Set(v) // A copy that later changes to the struct don't affect
+	frozenStructs.Store(enumType, frozen)
+	RegisterSymbols(enumType, list...)
+	return nil
+}
+
+// ValidateStruct returns an error for each field of a struct registered by RegisterStruct (or a
+// pointer to it) whose value differs from its registered value (like after EColor.Red = 123).
+func ValidateStruct(symbols interface{}) error {
+	v, err := structValue(symbols)
+	if err != nil {
+		return err
+	}
+	var frozen reflect.Value
+	for i := 0; i < v.NumField() && !frozen.IsValid(); i++ {
+		if f, ok := frozenStructs.Load(v.Type().Field(i).Type); ok && f.(reflect.Value).Type() == v.Type() {
+			frozen = f.(reflect.Value)
+		}
+	}
+	if !frozen.IsValid() {
+		return fmt.Errorf("%s isn't registered", v.Type())
+	}
+	errs := []error{}
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Type().Field(i); field.IsExported() && v.Field(i).Interface() != frozen.Field(i).Interface() {
+			errs = append(errs, fmt.Errorf("%s symbol %q was changed from %s to %s after it was registered", field.Type.Name(),
+				field.Name, underlyingString(frozen.Field(i)), underlyingString(v.Field(i))))
+		}
+	}
+	return errors.Join(errs...) // nil if no fields were changed
+}
+
+// structValue is an internal function that returns the struct value of a struct or pointer to one.
+func structValue(symbols interface{}) (reflect.Value, error) {
+	v := reflect.Indirect(reflect.ValueOf(symbols))
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%T isn't a struct or a pointer to a struct", symbols)
+	}
+	return v, nil
+}
@@ -0,0 +1,35 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+// A Tone value is an unsigned 8-bit integer
+type Tone uint8
+
+// ETone scopes Tone's "symbols" to a struct; RegisterStruct makes them available to the enum package
+var ETone = struct {
+	Low, Mid, High Tone
+}{Tone(0), Tone(1), Tone(2)}
+
+func ExampleRegisterStruct() {
+	if err := enum.RegisterStruct(ETone); err != nil {
+		printf("%v\n", err)
+	}
+	printf("%s\n", enum.StringInt(Tone(2), reflect.TypeOf(Tone(0))))
+
+	symbols := ETone // A copy so ETone isn't changed
+	symbols.Mid = Tone(123)
+	v, err := enum.Parse(reflect.TypeOf((*Tone)(nil)), "mid", true)
+	printf("%d %v\n", v, err) // The registered value even though a copy of ETone was changed
+	printf("%v\n", enum.ValidateStruct(ETone))
+	printf("%v\n", enum.ValidateStruct(symbols))
+
+	// Output:
+	// High
+	// 1 <nil>
+	// <nil>
+	// Tone symbol "Mid" was changed from 1 to 123 after it was registered
+}
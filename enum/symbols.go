@@ -0,0 +1,113 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// Symbol is one of an enum type's symbols as returned by an EnumSymbols method.
+type Symbol struct {
+	Name  string      // The symbol's name (used like a symbol method's name)
+	Value interface{} // The symbol's value (converted to the enum type)
+}
+
+// SymbolSource is implemented by an enum type that declares exactly which symbols it has; its
+// methods are then not considered symbols. Without it, every method that takes no arguments &
+// returns the enum type is a symbol, including helpers like Not or Normalize. For example:
+//
+//	func (Access) EnumSymbols() []enum.Symbol {
+//	   return []enum.Symbol{{"None", EAccess.None()}, {"Read", EAccess.Read()}, ...}
+//	}
+//	func (a Access) Not() Access { return ^a } // Not a symbol
+type SymbolSource interface {
+	EnumSymbols() []Symbol
+}
+
+// registeredSymbols maps an enum type's reflect.Type to the []Symbol registered for it.
+var registeredSymbols sync.Map
+
+// Register registers the symbols (name -> value) of an enum type whose symbols aren't methods,
+// like a type whose symbols are constants declared with iota, so that all of this package's
+// functions work with it. For example:
+//
+//	type Color int16
+//	const (
+//	   ColorNone Color = iota
+//	   ColorRed
+//	)
+//	func init() { enum.Register(map[string]Color{"None": ColorNone, "Red": ColorRed}) }
+//
+// Registering symbols for a type replaces any symbols previously registered for it.
+func Register[T comparable](symbols map[string]T) {
+	list := make([]Symbol, 0, len(symbols))
+	for name, value := range symbols {
+		list = append(list, Symbol{name, value})
+	}
+	RegisterSymbols(reflect.TypeOf(*new(T)), list...)
+}
+
+// RegisterSymbols is like Register but accepts the enum type & its symbols (whose values are
+// converted to the enum type); it panics if a value is nil or can't be converted.
+func RegisterSymbols(enumType reflect.Type, symbols ...Symbol) {
+	list := convertSymbols(enumType, symbols, "RegisterSymbols")
+	sort.SliceStable(list, func(i, j int) bool { // Order the symbols by value, then by name
+		a, b := reflect.ValueOf(list[i].Value), reflect.ValueOf(list[j].Value)
+		if aLess, bLess := lessValue(a, b), lessValue(b, a); aLess != bLess {
+			return aLess // The values differ
+		}
+		return list[i].Name < list[j].Name
+	})
+	registeredSymbols.Store(enumType, list)
+	descriptors.Delete(enumType) // The type's cached descriptor (if any) has its old symbols
+	describe(enumType)           // Register the type so LookupType finds it
+}
+
+// symbolsOf is an internal function that returns an enum type's registered symbols, the symbols
+// returned by its EnumSymbols method or else its symbol methods' symbols (in method name order).
+func symbolsOf(enumType reflect.Type) []Symbol {
+	if symbols, ok := registeredSymbols.Load(enumType); ok {
+		return symbols.([]Symbol)
+	}
+	zero := reflect.Zero(enumType)
+	if ss, ok := zero.Interface().(SymbolSource); ok {
+		return convertSymbols(enumType, ss.EnumSymbols(), "EnumSymbols")
+	}
+	symbols := []Symbol{}
+	args := [1]reflect.Value{zero} // Pass 1 argument that is a zero-value of t
+	for m := 0; m < enumType.NumMethod(); m++ {
+		method := enumType.Method(m)
+		if !isValidEnumSymbolMethod(enumType, method) {
+			continue
+		}
+		// Call the enum method, convert the result to the enumType interface
+		symbols = append(symbols, Symbol{method.Name, method.Func.Call(args[:])[0].Convert(enumType).Interface()})
+	}
+	return symbols
+}
+
+// convertSymbols is an internal function that returns a copy of symbols (obtained from source)
+// whose values are converted to enumType; it panics if a value is nil or can't be converted.
+func convertSymbols(enumType reflect.Type, symbols []Symbol, source string) []Symbol {
+	list := make([]Symbol, len(symbols))
+	for i, s := range symbols {
+		v := reflect.ValueOf(s.Value)
+		if !v.IsValid() || !v.Type().ConvertibleTo(enumType) {
+			panic(fmt.Sprintf("enum: %s symbol %q from %s has value %#v, which can't be converted to %s",
+				enumType, s.Name, source, s.Value, enumType))
+		}
+		list[i] = Symbol{s.Name, v.Convert(enumType).Interface()}
+	}
+	return list
+}
+
+// symbol is an internal method that returns the symbol named symbolName (exactly).
+func (d *descriptor) symbol(symbolName string) (Symbol, bool) {
+	for _, s := range d.symbols {
+		if s.Name == symbolName {
+			return s, true
+		}
+	}
+	return Symbol{}, false
+}
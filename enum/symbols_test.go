@@ -0,0 +1,62 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var ESwitch = Switch(0).Off() // Helper variable used by consuming code (improves cross-package consumption)
+type Switch uint8             // Switch declares its symbols with EnumSymbols so that its Toggled helper isn't a symbol
+
+// Define Switch's "symbols" and their values:
+func (Switch) Off() Switch { return Switch(0) }
+func (Switch) On() Switch  { return Switch(1) }
+
+// EnumSymbols returns Switch's symbols
+func (Switch) EnumSymbols() []enum.Symbol {
+	return []enum.Symbol{{Name: "Off", Value: ESwitch.Off()}, {Name: "On", Value: ESwitch.On()}}
+}
+
+// Toggled returns the opposite state; without EnumSymbols, it would be a symbol.
+func (s Switch) Toggled() Switch { return s ^ 1 }
+
+// String converts a Switch enum value to its equivalent "symbol"
+func (s Switch) String() string { return enum.StringInt(s, reflect.TypeOf(s)) }
+
+func ExampleSymbolSource() {
+	for name, value := range enum.All[Switch]() {
+		printf("%s=%d\n", name, value)
+	}
+	_, err := enum.Parse(reflect.TypeOf((*Switch)(nil)), "Toggled", false)
+	printf("%v\n", err)
+	printf("%s\n", ESwitch.On().Toggled())
+
+	// Output:
+	// Off=0
+	// On=1
+	// couldn't parse "Toggled" into a "Switch"
+	// Off
+}
+
+type Dial uint8 // Dial's EnumSymbols returns a symbol with no value
+
+// EnumSymbols returns Dial's symbols
+func (Dial) EnumSymbols() []enum.Symbol {
+	return []enum.Symbol{{Name: "Low", Value: 0}, {Name: "High"}}
+}
+
+func ExampleSymbolSource_invalid() {
+	func() {
+		defer func() { printf("%v\n", recover()) }()
+		enum.String(Dial(0), reflect.TypeOf(Dial(0)))
+	}()
+	func() {
+		defer func() { printf("%v\n", recover()) }()
+		enum.RegisterSymbols(reflect.TypeOf(Dial(0)), enum.Symbol{Name: "Max", Value: "max"})
+	}()
+
+	// Output:
+	// enum: enum_test.Dial symbol "High" from EnumSymbols has value <nil>, which can't be converted to enum_test.Dial
+	// enum: enum_test.Dial symbol "Max" from RegisterSymbols has value "max", which can't be converted to enum_test.Dial
+}
@@ -0,0 +1,46 @@
+package enum
+
+import "reflect"
+
+// SymbolWireKeys is implemented by an enum type whose symbols have stable wire keys that are
+// used (instead of their Go method names) by String & Parse. Since persisted strings use the
+// wire keys, symbol methods can be renamed without breaking persisted data. For example:
+//
+//	func (Color) EnumWireKeys() map[string]string {
+//	   return map[string]string{"Red": "r", "Green": "g", "Blue": "b"} // Symbol method name -> wire key
+//	}
+//
+// A wire key takes precedence over the type's NamingPolicy; Parse also accepts the Go method name.
+type SymbolWireKeys interface {
+	EnumWireKeys() map[string]string
+}
+
+// SymbolAliases is implemented by an enum type that has renamed symbols but still wants Parse
+// to accept their old names. For example:
+//
+//	func (Color) EnumAliases() map[string]string {
+//	   return map[string]string{"Crimson": "Red"} // Old symbol name -> current symbol method name
+//	}
+type SymbolAliases interface {
+	EnumAliases() map[string]string
+}
+
+// AliasUsed (if not nil) is invoked whenever Parse accepts an old symbol name declared by
+// SymbolAliases; use it to find persisted data that should be migrated. Set it during
+// initialization; it is not synchronized.
+var AliasUsed func(enumType reflect.Type, alias string, symbolName string)
+
+// resolveAlias is an internal method that returns the current symbol method name for s if s is
+// an old name of a symbol. An exact match is preferred; if several old names match s ignoring
+// case, the first in sorted order is used.
+func (d *descriptor) resolveAlias(s string, caseInsensitive bool) (alias string, symbolName string, found bool) {
+	if symbolName, ok := d.aliases[s]; ok {
+		return s, symbolName, true
+	}
+	for _, alias := range d.aliasNames {
+		if Naming(nil).matches(alias, s, caseInsensitive) {
+			return alias, d.aliases[alias], true
+		}
+	}
+	return "", "", false
+}
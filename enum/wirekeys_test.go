@@ -0,0 +1,91 @@
+package enum_test
+
+import (
+	"reflect"
+
+	"github.com/JeffreyRichter/enum/enum"
+)
+
+var ETier = Tier(0).Hot() // Helper variable used by consuming code (improves cross-package consumption)
+type Tier uint8           // Tier values are persisted using stable wire keys
+
+// Define Tier's "symbols" and their values (Cool was renamed from Warm & Archive from Frozen):
+func (Tier) Hot() Tier     { return Tier(0) }
+func (Tier) Cool() Tier    { return Tier(1) }
+func (Tier) Archive() Tier { return Tier(2) }
+
+// EnumWireKeys maps each symbol method name to the key used in persisted data
+func (Tier) EnumWireKeys() map[string]string {
+	return map[string]string{"Hot": "hot", "Cool": "cool", "Archive": "archive"}
+}
+
+// EnumAliases maps old symbol names that Parse still accepts to their current symbol method names
+func (Tier) EnumAliases() map[string]string {
+	return map[string]string{"Warm": "Cool", "Frozen": "Archive"}
+}
+
+// String converts a Tier enum value to its wire key
+func (t Tier) String() string {
+	return enum.String(t, reflect.TypeOf(t))
+}
+
+// Parse sets t if s matches a wire key, a symbol or an old symbol name
+func (t *Tier) Parse(s string) error {
+	v, err := enum.Parse(reflect.TypeOf(t), s, false)
+	if err == nil {
+		*t = v.(Tier)
+	}
+	return err
+}
+
+func ExampleSymbolWireKeys() {
+	enum.AliasUsed = func(enumType reflect.Type, alias string, symbolName string) {
+		printf("%s: %q is an old name for %q\n", enumType, alias, symbolName)
+	}
+	defer func() { enum.AliasUsed = nil }()
+
+	printf("Tier: %s\n", ETier.Archive()) // Calls String()
+
+	var t Tier
+	for _, s := range []string{"cool", "Cool", "Warm", "Frozen", "Lukewarm"} {
+		if err := t.Parse(s); err == nil {
+			printf("Parsed %q: %s\n", s, t)
+		} else {
+			printf("Parse error: %s\n", err)
+		}
+	}
+
+	// Output:
+	// Tier: archive
+	// Parsed "cool": cool
+	// Parsed "Cool": cool
+	// enum_test.Tier: "Warm" is an old name for "Cool"
+	// Parsed "Warm": cool
+	// enum_test.Tier: "Frozen" is an old name for "Archive"
+	// Parsed "Frozen": archive
+	// Parse error: couldn't parse "Lukewarm" into a "Tier"
+}
+
+var EZone = Zone(0).USWest() // Helper variable used by consuming code (improves cross-package consumption)
+type Zone uint8              // Zone's old names differ only by case
+
+// Define Zone's "symbols" and their values:
+func (Zone) USWest() Zone  { return Zone(0) }
+func (Zone) USWest2() Zone { return Zone(1) }
+
+// EnumAliases maps old symbol names that Parse still accepts to their current symbol method names
+func (Zone) EnumAliases() map[string]string {
+	return map[string]string{"WEST": "USWest2", "West": "USWest"}
+}
+
+func ExampleSymbolAliases() {
+	for _, s := range []string{"West", "WEST", "west"} {
+		v, err := enum.Parse(reflect.TypeOf((*Zone)(nil)), s, true)
+		printf("%-4s %v %v\n", s, enum.String(v, reflect.TypeOf(EZone)), err)
+	}
+
+	// Output:
+	// West USWest <nil>
+	// WEST USWest2 <nil>
+	// west USWest2 <nil>
+}